@@ -0,0 +1,766 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/audit"
+	"github.com/medoix/warehouse/equipment"
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/locations"
+	"github.com/medoix/warehouse/users"
+)
+
+// apiPrefix is the root of the JSON API. The version is part of the path so
+// breaking changes can be served next to the current API.
+const apiPrefix = "/api/v1"
+
+// apiRoutes registers the routes of the JSON API.
+func apiRoutes(mux *http.ServeMux) {
+	mux.HandleFunc(apiPrefix+"/inventory", apiInventory)
+	mux.HandleFunc(apiPrefix+"/inventory/", apiInventory)
+	mux.HandleFunc(apiPrefix+"/equipment", apiEquipment)
+	mux.HandleFunc(apiPrefix+"/equipment/", apiEquipment)
+	mux.HandleFunc(apiPrefix+"/locations", apiLocations)
+	mux.HandleFunc(apiPrefix+"/dashboard", apiDashboard)
+}
+
+// apiErrorBody is the body of every error response of the API.
+type apiErrorBody struct {
+	Error  string            `json:"error"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// apiJSON writes a JSON response with the given status code.
+func apiJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if v == nil {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("[ERR]", err)
+	}
+}
+
+// apiError writes an error response. The status code is taken from the
+// error when it is known, e.g. a missing item or an invalid field.
+func apiError(w http.ResponseWriter, status int, err error) {
+	body := &apiErrorBody{Error: err.Error()}
+
+	var verr *inventory.ValidationError
+	switch {
+	case errors.Is(err, inventory.ErrNotFound), errors.Is(err, equipment.ErrNotFound):
+		status = http.StatusNotFound
+	case errors.As(err, &verr):
+		status = http.StatusBadRequest
+		body.Fields = verr.Fields
+	}
+	if status >= http.StatusInternalServerError {
+		log.Println("[ERR]", err)
+	}
+
+	apiJSON(w, status, body)
+}
+
+// apiMethodNotAllowed rejects a request with a method the route does not
+// support.
+func apiMethodNotAllowed(w http.ResponseWriter, allowed ...string) {
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	apiError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+}
+
+// apiDecode decodes the JSON body of a request.
+func apiDecode(r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(io.LimitReader(r.Body, 1<<20))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+// apiPath splits the path of a request below a resource into the ID of the
+// item and the action on it, e.g. "/api/v1/equipment/drill/checkout".
+func apiPath(path, resource string) (id, action string) {
+	rest := strings.Trim(strings.TrimPrefix(path, resource), "/")
+	parts := strings.SplitN(rest, "/", 2)
+	id = parts[0]
+	if len(parts) > 1 {
+		action = parts[1]
+	}
+	return id, action
+}
+
+// text is a field that accepts a JSON string or number, so numeric values can
+// be sent either way and are parsed like the values of the web forms. Amounts
+// of money can also be sent as they are returned by the API.
+type text string
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (t *text) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*t = text(s)
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err == nil {
+		*t = text(n.String())
+		return nil
+	}
+	var m inventory.Money
+	if err := json.Unmarshal(b, &m); err == nil {
+		*t = text(m.String())
+		return nil
+	}
+	return fmt.Errorf("expected a string or a number, got %s", b)
+}
+
+// Inventory API
+
+// inventoryInput is the body used to create and update inventory items.
+type inventoryInput struct {
+	SKU      text `json:"sku"`
+	Name     text `json:"name"`
+	Type     text `json:"type"`
+	Value    text `json:"value"`
+	Size     text `json:"size"`
+	Quantity text `json:"quantity"`
+	Price    text `json:"price"`
+	Location text `json:"location"`
+
+	ReorderPoint    text     `json:"reorder_point"`
+	ReorderQuantity text     `json:"reorder_quantity"`
+	Barcodes        []string `json:"barcodes"`
+}
+
+func inventoryInputOf(item *inventory.Item) *inventoryInput {
+	in := &inventoryInput{
+		SKU:      text(item.SKU),
+		Name:     text(item.Name),
+		Type:     text(item.Type),
+		Value:    text(item.Value.String()),
+		Size:     text(strconv.FormatFloat(item.Size, 'f', -1, 64)),
+		Quantity: text(strconv.Itoa(item.Quantity)),
+		Price:    text(item.Price.String()),
+		Location: text(item.Location),
+
+		ReorderPoint:    text(strconv.Itoa(item.ReorderPoint)),
+		ReorderQuantity: text(strconv.Itoa(item.ReorderQuantity)),
+		Barcodes:        item.Barcodes,
+	}
+
+	// Fields with a legacy value are left empty, so the value is kept when a
+	// patch does not set them.
+	for field, t := range map[string]*text{"value": &in.Value, "size": &in.Size, "quantity": &in.Quantity, "price": &in.Price} {
+		if _, ok := item.Legacy[field]; ok {
+			*t = ""
+		}
+	}
+	return in
+}
+
+// movementInput is the body used to record a stock movement.
+type movementInput struct {
+	Kind     inventory.Kind `json:"kind"`
+	Quantity int            `json:"quantity"`
+	Reason   string         `json:"reason"`
+	Location string         `json:"location"`
+}
+
+// apiAllow checks that the user of a request has at least the given role and
+// rejects the request otherwise.
+func apiAllow(w http.ResponseWriter, r *http.Request, role users.Role) bool {
+	if !currentUser(r).Can(role) {
+		forbidden(w, r)
+		return false
+	}
+	return true
+}
+
+// apiWriteRole returns the role needed to make a request that changes items:
+// staff can add and edit them and only admins can delete them.
+func apiWriteRole(r *http.Request) users.Role {
+	if r.Method == "DELETE" {
+		return users.Admin
+	}
+	return users.Staff
+}
+
+func apiInventory(w http.ResponseWriter, r *http.Request) {
+	id, action := apiPath(r.URL.Path, apiPrefix+"/inventory")
+	if !apiAllow(w, r, apiWriteRole(r)) {
+		return
+	}
+
+	switch {
+	case id == "":
+		apiInventoryItems(w, r)
+	case action == "":
+		apiInventoryItem(w, r, id)
+	case action == "ledger":
+		apiInventoryLedger(w, r, id)
+	default:
+		apiError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func apiInventoryItems(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		// A barcode finds the single item with that ID or external barcode.
+		if code := r.FormValue("barcode"); code != "" {
+			item, err := inventory.FindBarcode(code)
+			if err != nil {
+				apiError(w, http.StatusInternalServerError, err)
+				return
+			}
+			apiJSON(w, http.StatusOK, []*inventory.Item{item})
+			return
+		}
+
+		items, err := inventory.Search(inventoryFilter(r))
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		inventory.Sort(inventory.ByName, items, false)
+		apiJSON(w, http.StatusOK, items)
+
+	case "POST":
+		in := &inventoryInput{}
+		if err := apiDecode(r, in); err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+		if in.Name == "" {
+			apiError(w, http.StatusBadRequest, errors.New("name is required"))
+			return
+		}
+
+		location, err := locationID(string(in.Location))
+		if err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		item, err := inventory.Add(string(in.SKU), string(in.Name), string(in.Type), string(in.Value),
+			string(in.Size), string(in.Quantity), string(in.Price), location,
+			string(in.ReorderPoint), string(in.ReorderQuantity), strings.Join(in.Barcodes, ","))
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "inventory", item.ID, audit.Add, audit.Diff(nil, item))
+
+		log.Println("[ADD]", item)
+		w.Header().Set("Location", apiPrefix+"/inventory/"+item.ID)
+		apiJSON(w, http.StatusCreated, item)
+
+	default:
+		apiMethodNotAllowed(w, "GET", "POST")
+	}
+}
+
+func apiInventoryItem(w http.ResponseWriter, r *http.Request, id string) {
+	item, err := inventory.Get(id)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		apiJSON(w, http.StatusOK, item)
+
+	case "PUT", "PATCH":
+		// PUT replaces every field of the item while PATCH only changes the
+		// fields present in the body.
+		in := &inventoryInput{}
+		if r.Method == "PATCH" {
+			in = inventoryInputOf(item)
+		}
+		if err := apiDecode(r, in); err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		location := string(in.Location)
+		if location != item.Location {
+			location, err = locationID(location)
+			if err != nil {
+				apiError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		before := item
+		item, err = inventory.Update(id, string(in.SKU), string(in.Name), string(in.Type), string(in.Value),
+			string(in.Size), string(in.Quantity), string(in.Price), location,
+			string(in.ReorderPoint), string(in.ReorderQuantity), strings.Join(in.Barcodes, ","))
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "inventory", id, audit.Update, audit.Diff(before, item))
+
+		log.Println("[EDIT]", item)
+		apiJSON(w, http.StatusOK, item)
+
+	case "DELETE":
+		if err := inventory.Delete(id); err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "inventory", id, audit.Delete, audit.Diff(item, nil))
+
+		log.Println("[DELETE]", id)
+		apiJSON(w, http.StatusNoContent, nil)
+
+	default:
+		apiMethodNotAllowed(w, "GET", "PUT", "PATCH", "DELETE")
+	}
+}
+
+func apiInventoryLedger(w http.ResponseWriter, r *http.Request, id string) {
+	item, err := inventory.Get(id)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		ledger, err := item.Ledger()
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		apiJSON(w, http.StatusOK, ledger)
+
+	case "POST":
+		in := &movementInput{}
+		if err := apiDecode(r, in); err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		location, err := locationID(in.Location)
+		if err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		before := *item
+		m, err := item.Move(in.Kind, in.Quantity, in.Reason, location)
+		if err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+		record(r, "inventory", item.ID, audit.Move, movementChanges(&before, item, m))
+
+		log.Println("[MOVE]", item.ID, m.Kind, m.Quantity)
+		apiJSON(w, http.StatusCreated, m)
+
+	default:
+		apiMethodNotAllowed(w, "GET", "POST")
+	}
+}
+
+// Equipment API
+
+// equipmentInput is the body used to create and update equipment.
+type equipmentInput struct {
+	Name  text `json:"name"`
+	Price text `json:"price"`
+}
+
+// checkoutInput is the body used to check out equipment. Due is a day like
+// 2006-01-02 or an RFC 3339 time, and defaults to the loan period.
+type checkoutInput struct {
+	Who string `json:"who"`
+	Due string `json:"due"`
+}
+
+// reservationInput is the body used to reserve equipment. Who defaults to the
+// user making the reservation.
+type reservationInput struct {
+	Who   string    `json:"who"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	Notes string    `json:"notes"`
+}
+
+func apiEquipment(w http.ResponseWriter, r *http.Request) {
+	id, action := apiPath(r.URL.Path, apiPrefix+"/equipment")
+	// Borrowers can look up equipment, check it out and in, and reserve it.
+	role := users.Borrower
+	if r.Method != "GET" && action != "checkout" && action != "return" && !strings.HasPrefix(action, "reservations") {
+		role = apiWriteRole(r)
+	}
+	if !apiAllow(w, r, role) {
+		return
+	}
+
+	switch {
+	case id == "":
+		apiEquipmentItems(w, r)
+	case action == "":
+		apiEquipmentItem(w, r, id)
+	case action == "history":
+		apiEquipmentHistory(w, r, id)
+	case action == "checkout":
+		apiEquipmentCheckout(w, r, id)
+	case action == "return":
+		apiEquipmentReturn(w, r, id)
+	case action == "reservations" || strings.HasPrefix(action, "reservations/"):
+		apiEquipmentReservations(w, r, id, strings.TrimPrefix(strings.TrimPrefix(action, "reservations"), "/"))
+	default:
+		apiError(w, http.StatusNotFound, errors.New("not found"))
+	}
+}
+
+func apiEquipmentItems(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		items, err := equipment.Search(equipmentFilter(r))
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		equipment.Sort(equipment.ByName, items, false)
+		apiJSON(w, http.StatusOK, items)
+
+	case "POST":
+		in := &equipmentInput{}
+		if err := apiDecode(r, in); err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+		if in.Name == "" {
+			apiError(w, http.StatusBadRequest, errors.New("name is required"))
+			return
+		}
+
+		item, err := equipment.Add(string(in.Name))
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		if in.Price != "" {
+			item, err = equipment.Update(item.ID, item.Name, string(in.Price))
+			if err != nil {
+				apiError(w, http.StatusInternalServerError, err)
+				return
+			}
+		}
+		record(r, "equipment", item.ID, audit.Add, audit.Diff(nil, item))
+
+		log.Println("[ADD]", item)
+		w.Header().Set("Location", apiPrefix+"/equipment/"+item.ID)
+		apiJSON(w, http.StatusCreated, item)
+
+	default:
+		apiMethodNotAllowed(w, "GET", "POST")
+	}
+}
+
+func apiEquipmentItem(w http.ResponseWriter, r *http.Request, id string) {
+	item, err := equipment.Get(id)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		apiJSON(w, http.StatusOK, item)
+
+	case "PUT", "PATCH":
+		in := &equipmentInput{}
+		if r.Method == "PATCH" {
+			in = &equipmentInput{Name: text(item.Name), Price: text(item.Price)}
+		}
+		if err := apiDecode(r, in); err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		before := item
+		item, err = equipment.Update(id, string(in.Name), string(in.Price))
+		if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "equipment", id, audit.Update, audit.Diff(before, item))
+
+		log.Println("[EDIT]", item)
+		apiJSON(w, http.StatusOK, item)
+
+	case "DELETE":
+		if err := equipment.Delete(id); err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "equipment", id, audit.Delete, audit.Diff(item, nil))
+
+		log.Println("[DELETE]", id)
+		apiJSON(w, http.StatusNoContent, nil)
+
+	default:
+		apiMethodNotAllowed(w, "GET", "PUT", "PATCH", "DELETE")
+	}
+}
+
+func apiEquipmentHistory(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != "GET" {
+		apiMethodNotAllowed(w, "GET")
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	history, err := item.History()
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	apiJSON(w, http.StatusOK, history)
+}
+
+func apiEquipmentCheckout(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != "POST" {
+		apiMethodNotAllowed(w, "POST")
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	in := &checkoutInput{}
+	if err := apiDecode(r, in); err != nil {
+		apiError(w, http.StatusBadRequest, err)
+		return
+	}
+	if in.Who == "" {
+		apiError(w, http.StatusBadRequest, errors.New("who is required"))
+		return
+	}
+	if item.InUse {
+		apiError(w, http.StatusConflict, fmt.Errorf("%s is already in use by %s", item.Name, item.Location))
+		return
+	}
+	due, err := parseDue(in.Due)
+	if err != nil {
+		apiError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	before := *item
+	err = item.Lend(in.Who, due)
+	var conflict *equipment.ConflictError
+	if errors.As(err, &conflict) || errors.Is(err, equipment.ErrOutOfService) || errors.Is(err, equipment.ErrServiceDue) {
+		apiError(w, http.StatusConflict, err)
+		return
+	} else if errors.Is(err, equipment.ErrPastDue) {
+		apiError(w, http.StatusBadRequest, err)
+		return
+	} else if errors.Is(err, equipment.ErrLent) {
+		apiError(w, http.StatusConflict, err)
+		return
+	} else if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	record(r, "equipment", item.ID, audit.Checkout, audit.Diff(&before, item))
+
+	log.Println("[USE]", item)
+	apiJSON(w, http.StatusOK, item)
+}
+
+// apiEquipmentReturn returns an item. Like the return page, it needs a picture
+// of the place the item was left at, sent either as the "image" field of a
+// multipart form or as the raw body of the request.
+func apiEquipmentReturn(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != "POST" {
+		apiMethodNotAllowed(w, "POST")
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if !item.InUse {
+		apiError(w, http.StatusConflict, fmt.Errorf("%s is not in use", item.Name))
+		return
+	}
+
+	var img io.ReadSeeker
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		file, _, err := r.FormFile("image")
+		if err != nil {
+			apiError(w, http.StatusBadRequest, fmt.Errorf("a picture of the return location is required: %w", err))
+			return
+		}
+		defer file.Close()
+		img = file
+	} else {
+		data, err := io.ReadAll(io.LimitReader(r.Body, 32<<20))
+		if err != nil || len(data) == 0 {
+			apiError(w, http.StatusBadRequest, errors.New("a picture of the return location is required"))
+			return
+		}
+		img = strings.NewReader(string(data))
+	}
+
+	location, err := locationID(r.FormValue("location"))
+	if err != nil {
+		apiError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	before := *item
+	if err := item.SetLocationPicture(img); err != nil {
+		apiError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err := item.Return(location); err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	record(r, "equipment", item.ID, audit.Return, audit.Diff(&before, item))
+
+	log.Println("[USE]", item)
+	apiJSON(w, http.StatusOK, item)
+}
+
+// apiEquipmentReservations lists the reservations of an item with GET, adds
+// one with POST, and cancels the reservation with the given ID with DELETE.
+func apiEquipmentReservations(w http.ResponseWriter, r *http.Request, id, reservation string) {
+	item, err := equipment.Get(id)
+	if err != nil {
+		apiError(w, http.StatusNotFound, err)
+		return
+	}
+
+	switch {
+	case r.Method == "GET" && reservation == "":
+		apiJSON(w, http.StatusOK, item.Reservations)
+
+	case r.Method == "POST" && reservation == "":
+		in := &reservationInput{}
+		if err := apiDecode(r, in); err != nil {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		}
+		if in.Who == "" {
+			in.Who = actor(r)
+		}
+
+		before := *item
+		before.Reservations = append([]*equipment.Reservation{}, item.Reservations...)
+		res, err := item.Reserve(in.Who, in.Start, in.End, in.Notes, actor(r))
+		var conflict *equipment.ConflictError
+		if errors.As(err, &conflict) {
+			apiError(w, http.StatusConflict, err)
+			return
+		} else if errors.Is(err, equipment.ErrNoWho) || errors.Is(err, equipment.ErrBadPeriod) {
+			apiError(w, http.StatusBadRequest, err)
+			return
+		} else if err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "equipment", item.ID, audit.Reserve, audit.Diff(&before, item))
+
+		log.Println("[RESERVE]", item.ID, res.Who, res.Start, res.End)
+		apiJSON(w, http.StatusCreated, res)
+
+	case r.Method == "DELETE" && reservation != "":
+		res, err := item.Reservation(reservation)
+		if err != nil {
+			apiError(w, http.StatusNotFound, err)
+			return
+		}
+		if !canCancel(r, res) {
+			forbidden(w, r)
+			return
+		}
+
+		before := *item
+		if err := item.Cancel(reservation); err != nil {
+			apiError(w, http.StatusInternalServerError, err)
+			return
+		}
+		record(r, "equipment", item.ID, audit.Cancel, audit.Diff(&before, item))
+
+		log.Println("[CANCEL]", item.ID, res.Who, res.Start)
+		apiJSON(w, http.StatusNoContent, nil)
+
+	case reservation == "":
+		apiMethodNotAllowed(w, "GET", "POST")
+	default:
+		apiMethodNotAllowed(w, "DELETE")
+	}
+}
+
+// Locations API
+
+// apiLocation is a location with its full name, as listed by the API.
+type apiLocation struct {
+	*locations.Location
+	Path string `json:"path"`
+}
+
+func apiLocations(w http.ResponseWriter, r *http.Request) {
+	if !apiAllow(w, r, users.Staff) {
+		return
+	}
+	if r.Method != "GET" {
+		apiMethodNotAllowed(w, "GET")
+		return
+	}
+
+	t, err := locations.Load()
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	list := []*apiLocation{}
+	for _, l := range t.All() {
+		list = append(list, &apiLocation{l, t.Name(l.ID)})
+	}
+	apiJSON(w, http.StatusOK, list)
+}
+
+func apiDashboard(w http.ResponseWriter, r *http.Request) {
+	if !apiAllow(w, r, users.Staff) {
+		return
+	}
+	if r.Method != "GET" {
+		apiMethodNotAllowed(w, "GET")
+		return
+	}
+
+	d, err := dashboardStats()
+	if err != nil {
+		apiError(w, http.StatusInternalServerError, err)
+		return
+	}
+	apiJSON(w, http.StatusOK, d)
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/medoix/warehouse/audit"
+	"github.com/medoix/warehouse/inventory"
+)
+
+// guest is the actor recorded for changes made from the public pages, e.g.
+// checking out equipment by scanning its QR code.
+const guest = "guest"
+
+// actor returns the name recorded in the audit trail for the user of a
+// request.
+func actor(r *http.Request) string {
+	if u := currentUser(r); u != nil {
+		return u.Username
+	}
+	return guest
+}
+
+// record adds a change made by the user of a request to the audit trail.
+// Failing to record it is logged, as the change itself is already done.
+func record(r *http.Request, entity, id string, action audit.Action, changes []audit.Change) {
+	err := audit.Record(&audit.Entry{
+		Actor:   actor(r),
+		Entity:  entity,
+		ID:      id,
+		Action:  action,
+		Changes: changes,
+	})
+	if err != nil {
+		log.Println("[ERR]", err)
+	}
+}
+
+// pictureChanged is the change recorded when a picture is replaced.
+func pictureChanged(name string) []audit.Change {
+	return []audit.Change{{Field: name, After: "replaced"}}
+}
+
+// Audit Functions
+func auditIndex(w http.ResponseWriter, r *http.Request) {
+	q := audit.Query{
+		Text:   r.FormValue("q"),
+		Entity: r.FormValue("entity"),
+		Actor:  r.FormValue("actor"),
+		Action: audit.Action(r.FormValue("action")),
+	}
+	if v := r.FormValue("from"); v != "" {
+		q.From, _ = time.ParseInLocation("2006-01-02", v, time.Local)
+	}
+	if v := r.FormValue("to"); v != "" {
+		// The end date is included in the search.
+		if to, err := time.ParseInLocation("2006-01-02", v, time.Local); err == nil {
+			q.To = to.AddDate(0, 0, 1)
+		}
+	}
+
+	entries, err := audit.Search(q)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if err := render(r).ExecuteTemplate(w, "audit",
+		&struct {
+			Title    string
+			Entries  []*audit.Entry
+			Query    audit.Query
+			From, To string
+			Entities []string
+			Actions  []audit.Action
+		}{
+			Title:    "Audit",
+			Entries:  entries,
+			Query:    q,
+			From:     r.FormValue("from"),
+			To:       r.FormValue("to"),
+			Entities: []string{"inventory", "equipment", "customer", "order", "purchase", "supplier", "location", "user"},
+			Actions:  audit.Actions,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// movementChanges returns the changes recorded for a stock movement: the
+// change of quantity of the item and the movement itself.
+func movementChanges(before, after *inventory.Item, m *inventory.Movement) []audit.Change {
+	changes := audit.Diff(before, after)
+	for _, c := range audit.Diff(nil, m) {
+		c.Field = "movement." + c.Field
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// recordMoves records the stock movements made on several items at once, e.g.
+// by receiving a purchase order.
+func recordMoves(r *http.Request, kind inventory.Kind, quantities map[string]int, reason string) {
+	for id, q := range quantities {
+		if q == 0 {
+			continue
+		}
+		record(r, "inventory", id, audit.Move, []audit.Change{
+			{Field: "movement.kind", After: kind},
+			{Field: "movement.quantity", After: q},
+			{Field: "movement.reason", After: reason},
+		})
+	}
+}
@@ -0,0 +1,269 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+)
+
+const (
+	collection = "audit"
+	auditLog   = "audit.jsonl"
+	// keyFormat splits the audit trail by month, so appending to it stays
+	// cheap as it grows.
+	keyFormat = "2006-01"
+)
+
+// Store is the storage backend of the audit trail (default: the ~/.warehouse
+// directory).
+var Store storage.Store = storage.NewDir(storage.DefaultPath())
+
+// Action is the kind of change recorded in the audit trail.
+type Action string
+
+const (
+	// Add is the creation of a record.
+	Add Action = "add"
+	// Update is a change of the fields of a record.
+	Update Action = "update"
+	// Delete is the removal of a record.
+	Delete Action = "delete"
+	// Picture is a change of a picture of a record.
+	Picture Action = "picture"
+	// Checkout is the checkout of equipment.
+	Checkout Action = "checkout"
+	// Return is the return of equipment.
+	Return Action = "return"
+	// Move is a stock movement of an inventory item.
+	Move Action = "move"
+	// Reserve is a reservation of equipment.
+	Reserve Action = "reserve"
+	// Cancel is the cancellation of a reservation of equipment.
+	Cancel Action = "cancel"
+	// Service is the maintenance of equipment.
+	Service Action = "service"
+)
+
+// Actions lists the kinds of changes recorded in the audit trail.
+var Actions = []Action{Add, Update, Delete, Picture, Checkout, Return, Move, Reserve, Cancel, Service}
+
+// Change is the value of a field before and after a change. Either value is
+// nil when the field did not exist on that side.
+type Change struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Entry is a change made to the warehouse.
+type Entry struct {
+	When    time.Time `json:"when"`
+	Actor   string    `json:"actor"`
+	Entity  string    `json:"entity"`
+	ID      string    `json:"id"`
+	Action  Action    `json:"action"`
+	Changes []Change  `json:"changes,omitempty"`
+}
+
+// Record adds an entry at the end of the audit trail. The time of the entry
+// is set to now if missing.
+func Record(e *Entry) error {
+	if e.When.IsZero() {
+		e.When = time.Now()
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("audit: could not marshal entry: %w", err)
+	}
+	data = append(data, '\n')
+	if err := Store.Append(collection, e.When.Format(keyFormat), auditLog, data); err != nil {
+		return fmt.Errorf("audit: could not write entry: %w", err)
+	}
+
+	return nil
+}
+
+// Entries returns every entry of the audit trail sorted from the newest to
+// the oldest.
+func Entries() ([]*Entry, error) {
+	files, err := Store.ReadAll(collection, auditLog)
+	if err != nil {
+		return nil, fmt.Errorf("audit: could not read audit trail: %w", err)
+	}
+
+	entries := []*Entry{}
+	for _, data := range files {
+		s := bufio.NewScanner(bytes.NewReader(data))
+		s.Buffer(nil, 1<<20)
+		for s.Scan() {
+			if len(bytes.TrimSpace(s.Bytes())) == 0 {
+				continue
+			}
+			e := &Entry{}
+			if err := json.Unmarshal(s.Bytes(), e); err != nil {
+				return nil, fmt.Errorf("audit: could not parse entry: %w", err)
+			}
+			entries = append(entries, e)
+		}
+		if err := s.Err(); err != nil {
+			return nil, fmt.Errorf("audit: could not read audit trail: %w", err)
+		}
+	}
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].When.After(entries[b].When)
+	})
+
+	return entries, nil
+}
+
+// Query filters the entries of the audit trail. Empty fields match every
+// entry.
+type Query struct {
+	// Text is searched in the actor, entity, ID, action and changed fields
+	// of the entries, ignoring case.
+	Text   string
+	Entity string
+	Actor  string
+	Action Action
+	From   time.Time
+	To     time.Time
+}
+
+// Search returns the entries of the audit trail matching the query sorted
+// from the newest to the oldest.
+func Search(q Query) ([]*Entry, error) {
+	entries, err := Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	found := []*Entry{}
+	for _, e := range entries {
+		if e.Matches(q) {
+			found = append(found, e)
+		}
+	}
+
+	return found, nil
+}
+
+// Matches reports whether the entry matches the query.
+func (e *Entry) Matches(q Query) bool {
+	switch {
+	case q.Entity != "" && e.Entity != q.Entity:
+		return false
+	case q.Actor != "" && e.Actor != q.Actor:
+		return false
+	case q.Action != "" && e.Action != q.Action:
+		return false
+	case !q.From.IsZero() && e.When.Before(q.From):
+		return false
+	case !q.To.IsZero() && !e.When.Before(q.To):
+		return false
+	case q.Text == "":
+		return true
+	}
+
+	text := strings.ToLower(q.Text)
+	fields := []string{e.Actor, e.Entity, e.ID, string(e.Action)}
+	for _, c := range e.Changes {
+		fields = append(fields, c.Field, fmt.Sprint(c.Before), fmt.Sprint(c.After))
+	}
+	for _, f := range fields {
+		if strings.Contains(strings.ToLower(f), text) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v → %v", c.Field, value(c.Before), value(c.After))
+}
+
+func value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return "—"
+	case string:
+		return v
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	return string(data)
+}
+
+// ignored lists the fields left out of diffs because they change on every
+// update.
+var ignored = map[string]bool{"updated": true}
+
+// Diff returns the fields that differ between two versions of a record. The
+// records are compared by their JSON fields, so either can be nil for an
+// added or deleted record.
+func Diff(before, after interface{}) []Change {
+	b, a := fields(before), fields(after)
+
+	names := []string{}
+	for name := range b {
+		names = append(names, name)
+	}
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []Change{}
+	for _, name := range names {
+		if ignored[name] || reflect.DeepEqual(b[name], a[name]) {
+			continue
+		}
+		changes = append(changes, Change{Field: name, Before: b[name], After: a[name]})
+	}
+
+	return changes
+}
+
+// fields returns the JSON fields of a record. Nested objects are flattened
+// into dotted names, e.g. "price.amount".
+func fields(v interface{}) map[string]interface{} {
+	m := map[string]interface{}{}
+	if v == nil || reflect.ValueOf(v).Kind() == reflect.Ptr && reflect.ValueOf(v).IsNil() {
+		return m
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return m
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return m
+	}
+	flatten("", obj, m)
+
+	return m
+}
+
+func flatten(prefix string, obj, m map[string]interface{}) {
+	for k, v := range obj {
+		if nested, ok := v.(map[string]interface{}); ok {
+			flatten(prefix+k+".", nested, m)
+			continue
+		}
+		m[prefix+k] = v
+	}
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/medoix/warehouse/audit"
+	"github.com/medoix/warehouse/users"
+)
+
+// sessionCookie is the name of the cookie holding the session token.
+const sessionCookie = "warehouse_session"
+
+type contextKey int
+
+const userKey contextKey = iota
+
+// currentUser returns the user logged in for a request, or nil for the public
+// pages.
+func currentUser(r *http.Request) *users.User {
+	u, _ := r.Context().Value(userKey).(*users.User)
+	return u
+}
+
+// isPublic reports whether a page can be reached without logging in: the login
+// page and the pages opened by scanning the QR code of equipment, including
+// its short link, so anyone can still check items out and in. Of the files of
+// equipment only the picture of the item is public, not the pictures and
+// attachments of its history and services.
+func isPublic(path string) bool {
+	switch path {
+	case "/login", "/logout", "/equipment/update":
+		return true
+	}
+	if strings.HasPrefix(path, "/q/"+linkEquipment+"/") {
+		return true
+	}
+	parts := strings.Split(path, "/")
+	return len(parts) == 4 && parts[1] == "equipment" && parts[2] != "" && parts[2] != "." && parts[2] != ".." && parts[3] == "picture.jpg"
+}
+
+// authenticate wraps the routes of the server so only logged in users can
+// reach them. Users are identified by their session cookie, or with basic
+// authentication for scripts using the JSON API.
+func authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u := requestUser(r); u != nil {
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), userKey, u)))
+			return
+		}
+		if isPublic(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, apiPrefix+"/") {
+			w.Header().Set("WWW-Authenticate", `Basic realm="warehouse"`)
+			apiError(w, http.StatusUnauthorized, errors.New("authentication required"))
+			return
+		}
+		http.Redirect(w, r, "/login?next="+url.QueryEscape(r.URL.RequestURI()), http.StatusSeeOther)
+	})
+}
+
+// allow restricts a handler to the users with at least the given role. The
+// public pages are left open to everyone.
+func allow(role users.Role, h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !isPublic(r.URL.Path) && !currentUser(r).Can(role) {
+			forbidden(w, r)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// render returns the templates for rendering a page of a request, with "user"
+// returning the user logged in, e.g. to only show the menu entries they can
+// open. The parsed templates are never executed themselves so they can be
+// cloned for every request.
+func render(r *http.Request) *template.Template {
+	t, err := templates.Clone()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return templates
+	}
+	u := currentUser(r)
+	return t.Funcs(template.FuncMap{
+		"user": func() *users.User { return u },
+	})
+}
+
+func forbidden(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, apiPrefix+"/") {
+		apiError(w, http.StatusForbidden, errors.New("not allowed"))
+		return
+	}
+	http.Error(w, "You are not allowed to do this.", http.StatusForbidden)
+}
+
+func requestUser(r *http.Request) *users.User {
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		if u, err := users.Session(c.Value); err == nil {
+			return u
+		}
+	}
+	if name, password, ok := r.BasicAuth(); ok {
+		if u, err := users.Authenticate(name, password); err == nil {
+			return u
+		}
+	}
+	return nil
+}
+
+// Login Functions
+func login(w http.ResponseWriter, r *http.Request) {
+	next := r.FormValue("next")
+	// Only redirect within the warehouse after logging in.
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") {
+		next = "/"
+	}
+
+	var loginErr string
+	if r.Method == "POST" {
+		u, err := users.Authenticate(r.FormValue("username"), r.FormValue("password"))
+		if err == nil {
+			token, err := users.NewSession(u)
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			// The session cookie is not sent by requests from other sites,
+			// which keeps the forms of the warehouse from being posted by them.
+			http.SetCookie(w, &http.Cookie{
+				Name:     sessionCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				Secure:   r.TLS != nil,
+				SameSite: http.SameSiteLaxMode,
+				MaxAge:   int(users.SessionLifetime.Seconds()),
+			})
+
+			log.Println("[LOGIN]", u)
+			http.Redirect(w, r, next, http.StatusSeeOther)
+			return
+		}
+		if err != users.ErrInvalidLogin {
+			log.Println("[ERR]", err)
+		}
+		loginErr = "Invalid username or password."
+		w.WriteHeader(http.StatusUnauthorized)
+	}
+
+	if err := render(r).ExecuteTemplate(w, "login",
+		&struct {
+			Title string
+			Next  string
+			Error string
+		}{
+			Title: "Login",
+			Next:  next,
+			Error: loginErr,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+func logout(w http.ResponseWriter, r *http.Request) {
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		users.EndSession(c.Value)
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:   sessionCookie,
+		Path:   "/",
+		MaxAge: -1,
+	})
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+// User Functions
+func usersIndex(w http.ResponseWriter, r *http.Request) {
+	list, err := users.Users()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if err := render(r).ExecuteTemplate(w, "users",
+		&struct {
+			Title string
+			Users []*users.User
+		}{
+			Title: "Users",
+			Users: list,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+func userAdd(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		role, err := users.ParseRole(r.FormValue("role"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		u, err := users.Add(r.FormValue("username"), r.FormValue("password"), role)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		record(r, "user", u.Username, audit.Add, audit.Diff(nil, u))
+
+		log.Println("[ADD]", u)
+		http.Redirect(w, r, "/users", http.StatusSeeOther)
+
+	case "GET":
+		if err := render(r).ExecuteTemplate(w, "user-add",
+			&struct {
+				Title string
+				Roles []users.Role
+			}{
+				Title: "New User",
+				Roles: users.Roles,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func userEdit(w http.ResponseWriter, r *http.Request) {
+	u, err := users.Get(r.FormValue("username"))
+	if err != nil {
+		http.Redirect(w, r, "/users", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		role, err := users.ParseRole(r.FormValue("role"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// Admins cannot lock themselves out by mistake.
+		if u.Username == currentUser(r).Username && role != users.Admin {
+			http.Error(w, "You cannot remove your own admin role.", http.StatusBadRequest)
+			return
+		}
+		before := u
+		u, err = users.Update(u.Username, r.FormValue("password"), role)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		changes := audit.Diff(before, u)
+		// Only the fact that the password changed is recorded.
+		if r.FormValue("password") != "" {
+			changes = append(changes, audit.Change{Field: "password", After: "changed"})
+		}
+		record(r, "user", u.Username, audit.Update, changes)
+
+		log.Println("[EDIT]", u)
+		http.Redirect(w, r, "/users", http.StatusSeeOther)
+
+	case "GET":
+		if err := render(r).ExecuteTemplate(w, "user-edit",
+			&struct {
+				Title string
+				User  *users.User
+				Roles []users.Role
+			}{
+				Title: u.Username,
+				User:  u,
+				Roles: users.Roles,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func userDelete(w http.ResponseWriter, r *http.Request) {
+	username := r.FormValue("username")
+	if r.Method != "POST" || username == "" {
+		http.Redirect(w, r, "/users", http.StatusSeeOther)
+		return
+	}
+	if username == currentUser(r).Username {
+		http.Error(w, "You cannot delete your own user.", http.StatusBadRequest)
+		return
+	}
+
+	u, err := users.Get(username)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if err := users.Delete(username); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	record(r, "user", username, audit.Delete, audit.Diff(u, nil))
+
+	log.Println("[DELETE]", username)
+	http.Redirect(w, r, "/users", http.StatusSeeOther)
+}
@@ -0,0 +1,235 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/medoix/warehouse/audit"
+	"github.com/medoix/warehouse/equipment"
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/locations"
+	"github.com/medoix/warehouse/storage"
+	"github.com/medoix/warehouse/users"
+	"golang.org/x/term"
+)
+
+// runCommand runs one of the subcommands of warehouse.
+func runCommand(name string, args []string) {
+	switch name {
+	case "migrate":
+		migrateCommand(args)
+	case "adduser":
+		addUserCommand(args)
+	case "migrate-locations":
+		migrateLocationsCommand(args)
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", name)
+		os.Exit(2)
+	}
+}
+
+// migrateCommand imports an existing warehouse directory into a sqlite
+// database, so the server can be started with `-store sqlite`.
+func migrateCommand(args []string) {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	path := fs.String("d", defaultPath(), "path to warehouse directory to import")
+	db := fs.String("db", "", "path to the sqlite database (default: <dir>/warehouse.db)")
+	fs.Parse(args)
+
+	if _, err := os.Stat(*path); err != nil {
+		fmt.Fprintf(os.Stderr, "error with warehouse path: %v\n", err)
+		os.Exit(1)
+	}
+
+	dst, err := openStore("sqlite", *path, *db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error opening database: %v\n", err)
+		os.Exit(1)
+	}
+	defer dst.Close()
+
+	if err := storage.Copy(dst, storage.NewDir(*path)); err != nil {
+		fmt.Fprintf(os.Stderr, "error importing warehouse: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("imported", *path, "into the sqlite database")
+}
+
+// addUserCommand adds a local user to the warehouse, e.g. the first admin:
+//
+//	warehouse adduser -name admin -role admin
+//
+// The password is asked on the terminal, or read from the first line of the
+// standard input when it is not a terminal.
+func addUserCommand(args []string) {
+	fs := flag.NewFlagSet("adduser", flag.ExitOnError)
+	path := fs.String("d", defaultPath(), "path to warehouse directory")
+	backend := fs.String("store", "dir", "storage backend: dir or sqlite")
+	db := fs.String("db", "", "path to the sqlite database (default: <dir>/warehouse.db)")
+	name := fs.String("name", "", "username of the new user")
+	role := fs.String("role", "staff", "role of the new user: borrower, staff or admin")
+	fs.Parse(args)
+
+	if *name == "" {
+		fmt.Fprintln(os.Stderr, "a username is required, use -name")
+		os.Exit(2)
+	}
+	r, err := users.ParseRole(*role)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if err := os.MkdirAll(*path, os.ModePerm); err != nil {
+		fmt.Fprintf(os.Stderr, "error with warehouse path: %v\n", err)
+		os.Exit(1)
+	}
+
+	store, err := openStore(*backend, *path, *db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error opening warehouse store: %v\n", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+	users.Store = store
+
+	password, err := readPassword()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error reading password: %v\n", err)
+		os.Exit(1)
+	}
+
+	u, err := users.Add(*name, password, r)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error adding user: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("added user", u.Username)
+}
+
+// migrateLocationsCommand maps the free text locations of the inventory and of
+// the equipment not in use to location records, adding a location for every
+// place that does not exist yet. It can be run again safely, e.g. after
+// importing old items.
+func migrateLocationsCommand(args []string) {
+	fs := flag.NewFlagSet("migrate-locations", flag.ExitOnError)
+	path := fs.String("d", defaultPath(), "path to warehouse directory")
+	backend := fs.String("store", "dir", "storage backend: dir or sqlite")
+	db := fs.String("db", "", "path to the sqlite database (default: <dir>/warehouse.db)")
+	kind := fs.String("kind", "shelf", "kind of the added locations: site, room, rack, shelf or bin")
+	fs.Parse(args)
+
+	k, err := locations.ParseKind(*kind)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if _, err := os.Stat(*path); err != nil {
+		fmt.Fprintf(os.Stderr, "error with warehouse path: %v\n", err)
+		os.Exit(1)
+	}
+
+	store, err := openStore(*backend, *path, *db)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error opening warehouse store: %v\n", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+	inventory.Store = store
+	equipment.Store = store
+	locations.Store = store
+	audit.Store = store
+
+	items, err := inventory.Items()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error reading inventory: %v\n", err)
+		os.Exit(1)
+	}
+	equip, err := equipment.Items()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error reading equipment: %v\n", err)
+		os.Exit(1)
+	}
+
+	// The location of equipment in use is the person using it, and equipment
+	// returned without a location is at none.
+	texts := []string{}
+	for _, i := range items {
+		texts = append(texts, i.Location)
+	}
+	for _, i := range equip {
+		if !i.InUse && i.Location != equipment.ReturnLocation {
+			texts = append(texts, i.Location)
+		}
+	}
+	ids, err := locations.Migrate(texts, k)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error adding locations: %v\n", err)
+		os.Exit(1)
+	}
+
+	moved := 0
+	migrated := func(entity, id, before, after string, update func() error) {
+		if err := update(); err != nil {
+			fmt.Fprintf(os.Stderr, "error updating %s %s: %v\n", entity, id, err)
+			return
+		}
+		moved++
+		err := audit.Record(&audit.Entry{
+			Actor:   "migrate-locations",
+			Entity:  entity,
+			ID:      id,
+			Action:  audit.Update,
+			Changes: []audit.Change{{Field: "location", Before: before, After: after}},
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error recording the update of %s %s: %v\n", entity, id, err)
+		}
+	}
+	for _, i := range items {
+		if id, ok := ids[strings.TrimSpace(i.Location)]; ok && id != i.Location {
+			before := i.Location
+			i.Location = id
+			migrated("inventory", i.ID, before, id, i.Update)
+		}
+	}
+	for _, i := range equip {
+		if id, ok := ids[strings.TrimSpace(i.Location)]; ok && !i.InUse && i.Location != equipment.ReturnLocation && id != i.Location {
+			before := i.Location
+			i.Location = id
+			migrated("equipment", i.ID, before, id, i.Update)
+		}
+	}
+	fmt.Printf("mapped %d locations, updated %d items\n", len(ids), moved)
+}
+
+func readPassword() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	fmt.Print("Password: ")
+	password, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	fmt.Print("Repeat password: ")
+	again, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", err
+	}
+	if string(password) != string(again) {
+		return "", fmt.Errorf("the passwords do not match")
+	}
+
+	return string(password), nil
+}
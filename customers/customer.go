@@ -0,0 +1,142 @@
+package customers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	collection   = "customers"
+	customerYAML = "info.yaml"
+)
+
+// Address is a postal address of a customer. The label tells addresses apart,
+// e.g. "billing" or "shipping".
+type Address struct {
+	Label    string `yaml:"label" json:"label"`
+	Street   string `yaml:"street" json:"street"`
+	City     string `yaml:"city" json:"city"`
+	State    string `yaml:"state" json:"state"`
+	Postcode string `yaml:"postcode" json:"postcode"`
+	Country  string `yaml:"country" json:"country"`
+}
+
+// Customer is a customer of the warehouse.
+type Customer struct {
+	ID        string    `yaml:"id" json:"id"`
+	Name      string    `yaml:"name" json:"name"`
+	Company   string    `yaml:"company" json:"company"`
+	Email     string    `yaml:"email" json:"email"`
+	Phone     string    `yaml:"phone" json:"phone"`
+	Addresses []Address `yaml:"addresses" json:"addresses"`
+	Notes     string    `yaml:"notes" json:"notes"`
+	Tags      []string  `yaml:"tags" json:"tags"`
+	Created   time.Time `yaml:"created" json:"created"`
+	Updated   time.Time `yaml:"update" json:"updated"`
+}
+
+// Update updates the information of the customer in the store.
+func (c *Customer) Update() error {
+	c.Updated = time.Now()
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("customers: could not marshal yaml file: %w", err)
+	}
+
+	if err := Store.Write(collection, c.ID, customerYAML, data); err != nil {
+		return fmt.Errorf("customers: could not write customer: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the customer from the store.
+func (c *Customer) Delete() error {
+	err := Store.Delete(collection, c.ID)
+	if err != nil {
+		return fmt.Errorf("customers: could not delete customer: %w", err)
+	}
+
+	return nil
+}
+
+// Address returns the address of the customer with the given label, or an
+// empty address if there is none.
+func (c *Customer) Address(label string) Address {
+	for _, a := range c.Addresses {
+		if a.Label == label {
+			return a
+		}
+	}
+	return Address{Label: label}
+}
+
+// HasTag reports whether the customer has a tag, ignoring case.
+func (c *Customer) HasTag(tag string) bool {
+	for _, t := range c.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (c *Customer) String() string {
+	return fmt.Sprintf("{%s (%s), Updated: %v}", c.ID, c.Name, c.Updated)
+}
+
+// String returns the address on a single line.
+func (a Address) String() string {
+	parts := []string{}
+	for _, p := range []string{a.Street, a.City, a.State, a.Postcode, a.Country} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// IsZero reports whether the address is empty, whatever its label.
+func (a Address) IsZero() bool {
+	return a.String() == ""
+}
+
+// ParseTags splits a comma separated list of tags, dropping empty and
+// repeated tags.
+func ParseTags(s string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	for _, t := range strings.Split(s, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[strings.ToLower(t)] {
+			continue
+		}
+		seen[strings.ToLower(t)] = true
+		tags = append(tags, t)
+	}
+	return tags
+}
+
+// Tags returns the distinct tags of the customers sorted by name.
+func Tags(customers []*Customer) []string {
+	seen := map[string]bool{}
+	tags := []string{}
+	for _, c := range customers {
+		for _, t := range c.Tags {
+			if seen[strings.ToLower(t)] {
+				continue
+			}
+			seen[strings.ToLower(t)] = true
+			tags = append(tags, t)
+		}
+	}
+	sort.Strings(tags)
+
+	return tags
+}
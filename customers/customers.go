@@ -0,0 +1,182 @@
+package customers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Store is the storage backend of the customers (default: the
+	// ~/.warehouse directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
+	// ErrNotFound is returned when a customer does not exist.
+	ErrNotFound = errors.New("customers: customer not found")
+	// ErrNoName is returned when adding or updating a customer without a
+	// name.
+	ErrNoName = errors.New("customers: a customer needs a name")
+)
+
+// Customers returns the list of customers sorted by name.
+func Customers() ([]*Customer, error) {
+	files, err := Store.ReadAll(collection, customerYAML)
+	if err != nil {
+		return nil, fmt.Errorf("customers: could not read customers: %w", err)
+	}
+
+	customers := []*Customer{}
+	for _, data := range files {
+		var c Customer
+		if e := yaml.Unmarshal(data, &c); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("customers: could not parse customer: %w", e))
+			continue
+		}
+		customers = append(customers, &c)
+	}
+	sort.Slice(customers, func(a, b int) bool {
+		return strings.ToLower(customers[a].Name) < strings.ToLower(customers[b].Name)
+	})
+
+	return customers, err
+}
+
+// Search returns the customers whose name, company, email, phone, addresses,
+// notes or tags contain every word of the text, ignoring case. An empty tag
+// matches every customer.
+func Search(text, tag string) ([]*Customer, error) {
+	customers, err := Customers()
+	if err != nil {
+		return nil, err
+	}
+
+	found := []*Customer{}
+	for _, c := range customers {
+		if c.Matches(text, tag) {
+			found = append(found, c)
+		}
+	}
+
+	return found, nil
+}
+
+// Matches reports whether the customer matches the search text and tag.
+func (c *Customer) Matches(text, tag string) bool {
+	if tag != "" && !c.HasTag(tag) {
+		return false
+	}
+
+	fields := []string{c.Name, c.Company, c.Email, c.Phone, c.Notes}
+	fields = append(fields, c.Tags...)
+	for _, a := range c.Addresses {
+		fields = append(fields, a.String())
+	}
+	all := strings.ToLower(strings.Join(fields, "\n"))
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		if !strings.Contains(all, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add adds a new customer. It will auto-generate a unique ID for the customer
+// based on the name.
+func Add(c *Customer) (*Customer, error) {
+	if strings.TrimSpace(c.Name) == "" {
+		return nil, ErrNoName
+	}
+	c.ID = uniqueKey(c.Name)
+	c.Created = time.Now()
+
+	if err := c.Update(); err != nil {
+		return nil, fmt.Errorf("customers: could not add customer: %w", err)
+	}
+
+	return c, nil
+}
+
+// Get returns the customer with the given ID.
+func Get(id string) (*Customer, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
+	}
+
+	c := &Customer{ID: id}
+	data, err := Store.Read(collection, id, customerYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("customers: could not read customer: %w", err)
+	}
+	if err := yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("customers: could not parse customer: %w", err)
+	}
+
+	return c, nil
+}
+
+// Update replaces the information of the customer with the same ID. The
+// creation date of the customer is kept.
+func Update(c *Customer) (*Customer, error) {
+	if strings.TrimSpace(c.Name) == "" {
+		return nil, ErrNoName
+	}
+	old, err := Get(c.ID)
+	if err != nil {
+		return nil, err
+	}
+	c.Created = old.Created
+
+	if err := c.Update(); err != nil {
+		return nil, fmt.Errorf("customers: could not update customer: %w", err)
+	}
+
+	return c, nil
+}
+
+// Delete deletes a customer.
+func Delete(id string) error {
+	c, err := Get(id)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Delete(); err != nil {
+		return fmt.Errorf("customers: could not delete customer: %w", err)
+	}
+	return nil
+}
+
+func uniqueKey(key string) string {
+	mark := 'a'
+	key = fmt.Sprintf("%.10s", clean(key))
+	if key == "" {
+		key = "customer"
+	}
+	valid := key
+
+	for exists(valid) {
+		valid = fmt.Sprintf("%s_%s", key, string(mark))
+		mark++
+	}
+
+	return valid
+}
+
+func exists(key string) bool {
+	ok, err := Store.Exists(collection, key)
+	return ok || err != nil
+}
+
+var nonAlnum = regexp.MustCompile("[^[:alnum:]]+")
+
+func clean(s string) string {
+	return strings.ToLower(nonAlnum.ReplaceAllString(s, ""))
+}
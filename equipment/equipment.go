@@ -3,90 +3,48 @@ package equipment
 import (
 	"bytes"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
 
-	"github.com/mitchellh/go-homedir"
+	"github.com/medoix/warehouse/storage"
 	"gopkg.in/yaml.v2"
 )
 
 var (
-	// CustomPath sets a custom directory to store the inventory.
-	CustomPath string
+	// Store is the storage backend of the inventory (default: the
+	// ~/.warehouse directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
 	// ReturnLocation sets the return location of the inventory (default:
-	// returned).
+	// returned). It marks items returned without a location rather than a
+	// place.
 	ReturnLocation = "returned"
+	// ErrNotFound is returned when an item does not exist in the inventory.
+	ErrNotFound = errors.New("equipment: item not found")
 )
 
+// mu serializes the changes of the items, so a checkout, return or
+// reservation made at the same time as another is not lost.
+var mu sync.Mutex
+
 // Items returns the list of items in the inventory.
 func Items() ([]*Item, error) {
-	path := getDir()
-	itemsDirs, err := ioutil.ReadDir(path)
-	if os.IsNotExist(err) {
-		fmt.Println("looks like", path, "does not exist, I will create it")
-		err = os.MkdirAll(path, os.ModePerm)
-		if err != nil {
-			return nil, fmt.Errorf("equipment: could not create directory: %w", err)
-		}
-		fmt.Println("done!")
-	} else if err != nil {
-		return nil, fmt.Errorf("equipment: could not read directory: %w", err)
+	files, err := Store.ReadAll(collection, itemYAML)
+	if err != nil {
+		return nil, fmt.Errorf("equipment: could not read items: %w", err)
 	}
 
-	var readers sync.WaitGroup
-	var managers sync.WaitGroup
-
 	items := []*Item{}
-	queue := make(chan *Item)
-	errors := make(chan error)
-
-	managers.Add(1)
-	go func() {
-		defer managers.Done()
-		for i := range queue {
-			items = append(items, i)
-		}
-	}()
-
-	managers.Add(1)
-	go func() {
-		defer managers.Done()
-		for e := range errors {
-			err = fmt.Errorf("%v\n%w", err, e)
+	for _, data := range files {
+		var i Item
+		if e := yaml.Unmarshal(data, &i); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("equipment: could not parse item: %w", e))
+			continue
 		}
-	}()
-
-	readers.Add(len(itemsDirs))
-	for _, dir := range itemsDirs {
-		dir := dir
-		go func() {
-			defer readers.Done()
-			if dir.IsDir() {
-				yamlFile := filepath.Join(path, dir.Name(), itemYAML)
-				data, err := ioutil.ReadFile(yamlFile)
-				if err != nil {
-					errors <- fmt.Errorf("equipment: could not parse item: %w", err)
-					return
-				}
-				var i Item
-				if err := yaml.Unmarshal(data, &i); err != nil {
-					errors <- fmt.Errorf("equipment: could not parse item: %w", err)
-					return
-				}
-				queue <- &i
-			}
-		}()
-	}
-
-	readers.Wait()
-	close(queue)
-	close(errors)
-	managers.Wait()
+		items = append(items, &i)
+	}
 
 	return items, err
 }
@@ -131,30 +89,68 @@ func Add(name string) (*Item, error) {
 	return item, nil
 }
 
-func getDir() string {
-	if CustomPath != "" {
-		CustomPath += "/equipment"
-		return CustomPath
+// Get returns the item of the inventory with the given ID.
+func Get(id string) (*Item, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
 	}
-	home, err := homedir.Dir()
+
+	item := &Item{ID: id}
+	data, err := Store.Read(collection, id, itemYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("equipment: could not read item: %w", err)
+	}
+	if err := yaml.Unmarshal(data, item); err != nil {
+		return nil, fmt.Errorf("equipment: could not parse item: %w", err)
+	}
+
+	return item, nil
+}
+
+// Update updates the name and price of an item in the inventory by ID. The use
+// state and location of the item are left untouched.
+func Update(id, name, price string) (*Item, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	item, err := Get(id)
 	if err != nil {
-		panic(err)
+		return nil, err
+	}
+	item.Name = name
+	item.Price = price
+
+	err = item.Update()
+	if err != nil {
+		return nil, fmt.Errorf("equipment: could not update item: %w", err)
 	}
-	return filepath.Join(home, ".warehouse/equipment")
+
+	return item, nil
 }
 
-// Path returns the path of the inventory.
-func Path() string {
-	return getDir()
+// Delete deletes an item from the inventory.
+func Delete(id string) error {
+	item := &Item{
+		ID: id,
+	}
+
+	err := item.Delete()
+	if err != nil {
+		return fmt.Errorf("equipment: could not delete item: %w", err)
+	}
+	return nil
 }
 
 func uniqueKey(key string) string {
-	path := getDir()
 	mark := 'a'
 	key = fmt.Sprintf("%.10s", clean(key))
+	if key == "" {
+		key = "item"
+	}
 	valid := key
 
-	for _, err := os.Stat(filepath.Join(path, valid)); !os.IsNotExist(err); _, err = os.Stat(filepath.Join(path, valid)) {
+	for exists(valid) {
 		valid = fmt.Sprintf("%s_%s", key, string(mark))
 		mark++
 	}
@@ -162,6 +158,11 @@ func uniqueKey(key string) string {
 	return valid
 }
 
+func exists(key string) bool {
+	ok, err := Store.Exists(collection, key)
+	return ok || err != nil
+}
+
 func clean(s string) string {
 	rx, err := regexp.Compile("[^[:alnum:][:space:]]+")
 	if err != nil {
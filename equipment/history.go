@@ -0,0 +1,104 @@
+package equipment
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	itemHistory = "history.yaml"
+	historyDir  = "history"
+)
+
+// Action is the kind of event recorded in the history of an item.
+type Action string
+
+const (
+	// Checkout is recorded when someone starts using an item.
+	Checkout Action = "checkout"
+	// Return is recorded when an item is returned.
+	Return Action = "return"
+)
+
+// Event is an entry of the checkout history of an item.
+type Event struct {
+	Who     string    `yaml:"who" json:"who"`
+	When    time.Time `yaml:"when" json:"when"`
+	Action  Action    `yaml:"action" json:"action"`
+	Picture string    `yaml:"picture,omitempty" json:"picture,omitempty"`
+	// Due is the due date of a checkout.
+	Due time.Time `yaml:"due,omitempty" json:"due,omitempty"`
+}
+
+// History returns the checkout history of the item sorted from the oldest to
+// the newest event.
+func (i *Item) History() ([]*Event, error) {
+	data, err := Store.Read(collection, i.ID, itemHistory)
+	if err == storage.ErrNotExist {
+		return []*Event{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("equipment: could not read history: %w", err)
+	}
+
+	events := []*Event{}
+	if err := yaml.Unmarshal(data, &events); err != nil {
+		return nil, fmt.Errorf("equipment: could not parse history: %w", err)
+	}
+	sort.SliceStable(events, func(a, b int) bool {
+		return events[a].When.Before(events[b].When)
+	})
+
+	return events, nil
+}
+
+// HolderAt returns who was using the item at the given time. It returns false
+// if the item was not checked out at that time.
+func (i *Item) HolderAt(t time.Time) (string, bool, error) {
+	events, err := i.History()
+	if err != nil {
+		return "", false, err
+	}
+
+	var last *Event
+	for _, e := range events {
+		if e.When.After(t) {
+			break
+		}
+		last = e
+	}
+	if last == nil || last.Action != Checkout {
+		return "", false, nil
+	}
+
+	return last.Who, true, nil
+}
+
+// record appends an event to the history of the item. The history file is a
+// yaml list that is only ever appended to, so previous events are never lost.
+// Return events keep a copy of the current location picture.
+func (i *Item) record(e *Event) error {
+	if e.Action == Return {
+		e.Picture = fmt.Sprintf("%s/%d.jpg", historyDir, e.When.UnixNano())
+		pic, err := Store.Read(collection, i.ID, itemLocPic)
+		if err != nil {
+			return fmt.Errorf("equipment: could not keep location picture: %w", err)
+		}
+		if err := Store.Write(collection, i.ID, e.Picture, pic); err != nil {
+			return fmt.Errorf("equipment: could not keep location picture: %w", err)
+		}
+	}
+
+	data, err := yaml.Marshal([]*Event{e})
+	if err != nil {
+		return fmt.Errorf("equipment: could not marshal history: %w", err)
+	}
+	if err := Store.Append(collection, i.ID, itemHistory, data); err != nil {
+		return fmt.Errorf("equipment: could not write history: %w", err)
+	}
+
+	return nil
+}
@@ -1,13 +1,11 @@
 package equipment
 
 import (
+	"bytes"
 	"fmt"
 	"image"
 	"image/jpeg"
 	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
 	"sort"
 	"time"
 
@@ -17,37 +15,61 @@ import (
 )
 
 const (
+	collection = "equipment"
 	itemYAML   = "info.yaml"
 	itemPic    = "picture.jpg"
 	itemLocPic = "location.jpg"
 	retCODE    = "RETURN_CODE"
 )
 
+// ReturnCode is passed to `Use` instead of a name to return an item.
+const ReturnCode = retCODE
+
 // Item is the item in the inventory.
 type Item struct {
-	ID       string    `yaml:"id"`
-	Name     string    `yaml:"name"`
-	Price	 string    `yaml:"price"`
-	Location string    `yaml:"location"`
-	Updated  time.Time `yaml:"update"`
-	InUse    bool      `yaml:"borrowed"`
+	ID       string    `yaml:"id" json:"id"`
+	Name     string    `yaml:"name" json:"name"`
+	Price	 string    `yaml:"price" json:"price"`
+	Location string    `yaml:"location" json:"location"`
+	Updated  time.Time `yaml:"update" json:"updated"`
+	InUse    bool      `yaml:"borrowed" json:"in_use"`
+	// Loan is the current loan of the item while it is in use.
+	Loan *Loan `yaml:"loan,omitempty" json:"loan,omitempty"`
+	// Reservations book the item for later, sorted by start.
+	Reservations []*Reservation `yaml:"reservations,omitempty" json:"reservations,omitempty"`
+	// Plans are the periodic maintenance of the item.
+	Plans []*Plan `yaml:"plans,omitempty" json:"plans,omitempty"`
+	// OutOfService is why the item cannot be checked out, or empty when it
+	// is in service.
+	OutOfService string `yaml:"out_of_service,omitempty" json:"out_of_service,omitempty"`
 }
 
-// Update updates the information of the item on disk.
+// Update updates the information of the item in the store.
 func (i *Item) Update() error {
 	i.Updated = time.Now()
+	return i.write()
+}
 
-	err := os.MkdirAll(i.path(""), os.ModePerm)
-	if err != nil {
-		return fmt.Errorf("equipment: could not create item directory: %w", err)
-	}
-
+// write writes the item to the store without changing its update time.
+func (i *Item) write() error {
 	data, err := yaml.Marshal(i)
 	if err != nil {
 		return fmt.Errorf("equipment: could not marshal yaml file: %w", err)
 	}
 
-	ioutil.WriteFile(i.path(itemYAML), data, 0644)
+	if err := Store.Write(collection, i.ID, itemYAML, data); err != nil {
+		return fmt.Errorf("equipment: could not write item: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the item from the store.
+func (i *Item) Delete() error {
+	err := Store.Delete(collection, i.ID)
+	if err != nil {
+		return fmt.Errorf("equipment: could not delete item directory: %w", err)
+	}
 
 	return nil
 }
@@ -55,16 +77,16 @@ func (i *Item) Update() error {
 // SetPicture sets the thumbnail picture of the item. To save space, the image
 // is resized within 1000x1000 pixels and encoded as jpeg.
 func (i *Item) SetPicture(r io.ReadSeeker) error {
-	return parseImg(r, 1000, i.path(itemPic))
+	return i.setImg(r, 1000, itemPic)
 }
 
 // SetLocationPicture sets the thumbnail picture of the item. To save space, the image
 // is resized within 1000x1000 pixels and encoded as jpeg.
 func (i *Item) SetLocationPicture(r io.ReadSeeker) error {
-	return parseImg(r, 1500, i.path(itemLocPic))
+	return i.setImg(r, 1500, itemLocPic)
 }
 
-func parseImg(r io.ReadSeeker, size int, filepath string) error {
+func (i *Item) setImg(r io.ReadSeeker, size int, name string) error {
 	data, _, err := exiffix.Decode(r)
 	if err != nil {
 		return fmt.Errorf("equipment: could not decode image: %w", err)
@@ -72,42 +94,85 @@ func parseImg(r io.ReadSeeker, size int, filepath string) error {
 
 	img := imaging.Thumbnail(data, size, size, imaging.Lanczos)
 
-	file, err := os.Create(filepath)
-	if err != nil {
-		return fmt.Errorf("equipment: could not create image file: %w", err)
-	}
-	defer file.Close()
-	err = jpeg.Encode(file, img, nil)
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, img, nil)
 	if err != nil {
 		return fmt.Errorf("equipment: could not encode image file: %w", err)
 	}
+	if err := Store.Write(collection, i.ID, name, buf.Bytes()); err != nil {
+		return fmt.Errorf("equipment: could not create image file: %w", err)
+	}
 
 	return nil
 }
 
 // Picture returns the picture associated with the item.
 func (i *Item) Picture() (image.Image, error) {
-	return getImg(i.path(itemPic))
+	return i.getImg(itemPic)
 }
 
 // LocationPicture returns the picture of the location associated with the item.
 func (i *Item) LocationPicture() (image.Image, error) {
-	return getImg(i.path(itemLocPic))
+	return i.getImg(itemLocPic)
 }
 
 // Use sets who is currently using the item and updates the information on
-// disk. If the return code `retCODE` is passed, the item is set as returned to
-// the `ReturnLocation`.
+// disk. The item is lent for the `LoanPeriod`, unless it is out of service. If
+// the return code `retCODE` is passed, the item is returned without a
+// location, see `Return`. Every checkout and return is added to the history
+// of the item.
 func (i *Item) Use(who string) error {
-	if who == retCODE {
-		i.InUse = false
-		i.Location = ReturnLocation
-	} else {
-		i.InUse = true
-		i.Location = who
+	if who != retCODE {
+		return i.Lend(who, time.Now().Add(LoanPeriod))
 	}
+	return i.Return("")
+}
 
-	return i.Update()
+// Return sets the item as returned to a location, given by its ID, and adds
+// the return to the history of the item. Items returned without a location
+// are at the `ReturnLocation`.
+func (i *Item) Return(location string) error {
+	if location == "" {
+		location = ReturnLocation
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return err
+	}
+
+	event := &Event{
+		Who:    i.Location,
+		When:   time.Now(),
+		Action: Return,
+	}
+	if !i.InUse {
+		event = nil
+	}
+	i.InUse = false
+	i.Location = location
+	i.Loan = nil
+
+	if err := i.Update(); err != nil {
+		return err
+	}
+	if event == nil {
+		return nil
+	}
+
+	return i.record(event)
+}
+
+// reload reads the item again from the store, so the checks made while
+// holding `mu` see the changes made by others since the item was read.
+func (i *Item) reload() error {
+	current, err := Get(i.ID)
+	if err != nil {
+		return err
+	}
+	*i = *current
+	return nil
 }
 
 // String implements the Stringer interface.
@@ -115,14 +180,13 @@ func (i *Item) String() string {
 	return fmt.Sprintf("{%s (%s) at %s, InUse: %v, Updated: %v}", i.ID, i.Name, i.Location, i.InUse, i.Updated)
 }
 
-func getImg(filepath string) (image.Image, error) {
-	file, err := os.Open(filepath)
+func (i *Item) getImg(name string) (image.Image, error) {
+	data, err := Store.Read(collection, i.ID, name)
 	if err != nil {
 		return nil, fmt.Errorf("equipment: could not open image file: %w", err)
 	}
-	defer file.Close()
 
-	img, err := jpeg.Decode(file)
+	img, err := jpeg.Decode(bytes.NewReader(data))
 	if err != nil {
 		return nil, fmt.Errorf("equipment: could not decode image: %w", err)
 	}
@@ -130,13 +194,6 @@ func getImg(filepath string) (image.Image, error) {
 	return img, nil
 }
 
-func (i *Item) path(filename string) string {
-	if filename == "" {
-		return filepath.Join(getDir(), i.ID)
-	}
-	return filepath.Join(getDir(), i.ID, filename)
-}
-
 // by is the type of the `Less` function.
 type by func(i1, i2 *Item) bool
 
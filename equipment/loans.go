@@ -0,0 +1,192 @@
+package equipment
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// LoanPeriod is how long an item is lent when no due date is given
+	// (default: 14 days).
+	LoanPeriod = 14 * 24 * time.Hour
+	// RemindInterval is how often a reminder is sent for an overdue loan
+	// (default: daily).
+	RemindInterval = 24 * time.Hour
+	// ErrPastDue is returned when an item is lent with a due date in the
+	// past.
+	ErrPastDue = errors.New("equipment: the due date is in the past")
+	// ErrLent is returned when an item is lent before it is returned.
+	ErrLent = errors.New("equipment: the item is already lent")
+)
+
+// Loan is the checkout of an item by someone until a due date.
+type Loan struct {
+	ID    string    `yaml:"-" json:"id,omitempty"`
+	Name  string    `yaml:"-" json:"name,omitempty"`
+	Who   string    `yaml:"who" json:"who"`
+	Since time.Time `yaml:"since" json:"since"`
+	Due   time.Time `yaml:"due" json:"due"`
+	// Reminded is when the last reminder of the overdue loan was sent.
+	Reminded *time.Time `yaml:"reminded,omitempty" json:"reminded,omitempty"`
+}
+
+// Overdue reports whether the item was not returned by the due date.
+func (l *Loan) Overdue() bool {
+	return time.Now().After(l.Due)
+}
+
+// DaysOverdue returns the number of whole days since the due date.
+func (l *Loan) DaysOverdue() int {
+	if !l.Overdue() {
+		return 0
+	}
+	return int(time.Since(l.Due) / (24 * time.Hour))
+}
+
+// DueOn returns the due time of a loan due on the given date: the end of the
+// day, after which the loan is overdue.
+func DueOn(date time.Time) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, date.Location()).Add(-time.Second)
+}
+
+// Lend checks the item out to someone until the due date, and adds the
+// checkout to the history of the item. It fails with `ErrLent` if the item
+// is already lent, even overdue, with a `*ConflictError` if it is reserved
+// for someone else before the due date, and with `ErrOutOfService` or
+// `ErrServiceDue` if it cannot be used until serviced. The reservations of the
+// borrower during the loan are fulfilled and removed.
+func (i *Item) Lend(who string, due time.Time) error {
+	now := time.Now()
+	if due.Before(now) {
+		return ErrPastDue
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return err
+	}
+	// The item must be returned before it is lent again, or the current loan
+	// would be lost from its history.
+	l, err := i.CurrentLoan()
+	if err != nil {
+		return err
+	}
+	if l != nil {
+		return ErrLent
+	}
+	if err := i.CheckService(); err != nil {
+		return err
+	}
+	c, err := i.Conflict(who, now, due)
+	if err != nil {
+		return err
+	}
+	if c != nil {
+		return c
+	}
+
+	kept := []*Reservation{}
+	for _, r := range i.Reservations {
+		if !r.Overlaps(now, due) {
+			kept = append(kept, r)
+		}
+	}
+	i.Reservations = kept
+
+	i.InUse = true
+	i.Location = who
+	i.Loan = &Loan{Who: who, Since: now, Due: due}
+	if err := i.Update(); err != nil {
+		return err
+	}
+
+	return i.record(&Event{Who: who, When: now, Action: Checkout, Due: due})
+}
+
+// CurrentLoan returns the loan of the item, or nil if it is not in use. Items
+// checked out before loans had a due date are due after the `LoanPeriod`
+// since their last checkout.
+func (i *Item) CurrentLoan() (*Loan, error) {
+	if !i.InUse {
+		return nil, nil
+	}
+
+	l := &Loan{ID: i.ID, Name: i.Name, Who: i.Location, Since: i.Updated}
+	if i.Loan != nil {
+		*l = *i.Loan
+		l.ID, l.Name = i.ID, i.Name
+	} else {
+		events, err := i.History()
+		if err != nil {
+			return nil, err
+		}
+		if n := len(events); n > 0 && events[n-1].Action == Checkout {
+			l.Since = events[n-1].When
+		}
+		l.Due = l.Since.Add(LoanPeriod)
+	}
+
+	return l, nil
+}
+
+// Overdue reports whether the item is in use past the due date of its loan.
+func (i *Item) Overdue() bool {
+	l, err := i.CurrentLoan()
+	return err == nil && l != nil && l.Overdue()
+}
+
+// Remind calls remind with every overdue loan that was not reminded within
+// the `RemindInterval`, and records when the reminder was sent. Loans whose
+// reminder failed are tried again on the next call.
+func Remind(remind func(l *Loan) error) error {
+	items, err := Items()
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, i := range items {
+		l, err := i.CurrentLoan()
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if l == nil || !l.Overdue() || l.Reminded != nil && time.Since(*l.Reminded) < RemindInterval {
+			continue
+		}
+
+		if err := remind(l); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := i.reminded(l, time.Now()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// reminded records when the reminder of a loan was sent, if the loan is still
+// the current loan of the item. Only the time of the reminder is saved, so the
+// changes made to the item while the reminder was sent are kept.
+func (i *Item) reminded(l *Loan, when time.Time) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return err
+	}
+
+	current, err := i.CurrentLoan()
+	if err != nil {
+		return err
+	}
+	if current == nil || current.Who != l.Who || !current.Since.Equal(l.Since) {
+		return nil
+	}
+	current.Reminded = &when
+	i.Loan = current
+	return i.write()
+}
@@ -0,0 +1,228 @@
+package equipment
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+)
+
+func TestDueOn(t *testing.T) {
+	sydney := time.FixedZone("AEST", 10*60*60)
+	tests := []struct {
+		date time.Time
+		want time.Time
+	}{
+		{
+			date: time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 14, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			date: time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 3, 14, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			date: time.Date(2026, 2, 28, 23, 59, 59, 0, time.UTC),
+			want: time.Date(2026, 2, 28, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			date: time.Date(2026, 12, 31, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			date: time.Date(2026, 7, 1, 8, 0, 0, 0, sydney),
+			want: time.Date(2026, 7, 1, 23, 59, 59, 0, sydney),
+		},
+	}
+
+	for _, tt := range tests {
+		if got := DueOn(tt.date); !got.Equal(tt.want) {
+			t.Errorf("DueOn(%s) = %s, want %s", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestLend(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	item, err := Add("Drill")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := item.Lend("kim", time.Now().Add(-time.Hour)); !errors.Is(err, ErrPastDue) {
+		t.Errorf("Lend with a past due date = %v, want ErrPastDue", err)
+	}
+
+	due := time.Now().Add(48 * time.Hour)
+	if err := item.Lend("kim", due); err != nil {
+		t.Fatal(err)
+	}
+	if !item.InUse || item.Location != "kim" || item.Loan == nil || !item.Loan.Due.Equal(due) {
+		t.Fatalf("after Lend the item is %+v, want it lent to kim", item)
+	}
+
+	// The loan cannot be taken over, by someone else or by the borrower.
+	for _, who := range []string{"lee", "kim"} {
+		if err := item.Lend(who, due); !errors.Is(err, ErrLent) {
+			t.Errorf("Lend(%q) of a lent item = %v, want ErrLent", who, err)
+		}
+	}
+
+	// An overdue loan is not lost either.
+	item.Loan.Due = time.Now().Add(-time.Hour)
+	if err := item.Lend("lee", due); !errors.Is(err, ErrLent) {
+		t.Errorf("Lend of an overdue item = %v, want ErrLent", err)
+	}
+
+	events, err := item.History()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Action != Checkout || events[0].Who != "kim" {
+		t.Errorf("the history is %+v, want the single checkout to kim", events)
+	}
+
+	if err := item.Use(ReturnCode); err != nil {
+		t.Fatal(err)
+	}
+	if err := item.Lend("lee", due); err != nil {
+		t.Errorf("Lend after the return = %v, want no error", err)
+	}
+}
+
+func TestLendStaleCopy(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	item, err := Add("Drill")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Two requests load the item before either checks it out.
+	first, err := Get(item.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Get(item.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	due := time.Now().Add(48 * time.Hour)
+	if err := first.Lend("kim", due); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Lend("lee", due); !errors.Is(err, ErrLent) {
+		t.Errorf("Lend of the second copy = %v, want ErrLent", err)
+	}
+	if second.Location != "kim" {
+		t.Errorf("the second copy is at %q, want it reloaded as lent to kim", second.Location)
+	}
+
+	// A reservation made from a stale copy does not undo the checkout.
+	if _, err := item.Reserve("lee", due.Add(time.Hour), due.Add(2*time.Hour), "", ""); err != nil {
+		t.Fatal(err)
+	}
+	current, err := Get(item.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !current.InUse || len(current.Reservations) != 1 {
+		t.Errorf("the item is %+v, want it lent with one reservation", current)
+	}
+}
+
+func TestReturn(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	item, err := Add("Drill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Location != ReturnLocation {
+		t.Errorf("a new item is at %q, want %q", item.Location, ReturnLocation)
+	}
+
+	if err := item.Lend("kim", time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := item.Return("shelf-a"); err != nil {
+		t.Fatal(err)
+	}
+	if item.InUse || item.Location != "shelf-a" || item.Loan != nil {
+		t.Errorf("after Return the item is %+v, want it returned to shelf-a", item)
+	}
+
+	events, err := item.History()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(events); n != 2 || events[1].Action != Return || events[1].Who != "kim" {
+		t.Errorf("the history is %+v, want the checkout and return by kim", events)
+	}
+}
+
+func TestRemind(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	item, err := Add("Drill")
+	if err != nil {
+		t.Fatal(err)
+	}
+	overdue := func() {
+		t.Helper()
+		if err := item.Lend("kim", time.Now().Add(time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		item.Loan.Due = time.Now().Add(-time.Hour)
+		if err := item.write(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The item is reserved while the reminder is sent.
+	overdue()
+	reminders := 0
+	err = Remind(func(l *Loan) error {
+		reminders++
+		start := time.Now().Add(24 * time.Hour)
+		_, err := item.Reserve("lee", start, start.Add(24*time.Hour), "", "")
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := Get(item.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.Loan == nil || current.Loan.Reminded == nil || len(current.Reservations) != 1 {
+		t.Errorf("the item is %+v with %+v, want the reminder and the reservation saved", current, current.Loan)
+	}
+	if err := Remind(func(l *Loan) error { reminders++; return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if reminders != 1 {
+		t.Errorf("%d reminders were sent, want 1 within the interval", reminders)
+	}
+
+	// The item is returned while the reminder is sent.
+	if err := item.Use(ReturnCode); err != nil {
+		t.Fatal(err)
+	}
+	overdue()
+	err = Remind(func(l *Loan) error {
+		return item.Use(ReturnCode)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err = Get(item.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.InUse || current.Loan != nil {
+		t.Errorf("the item is %+v, want it returned", current)
+	}
+}
@@ -0,0 +1,330 @@
+package equipment
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	itemServices = "services.yaml"
+	servicesDir  = "services"
+)
+
+var (
+	// ErrOutOfService is returned when an item out of service is checked
+	// out.
+	ErrOutOfService = errors.New("equipment: the item is out of service")
+	// ErrServiceDue is returned when an item is checked out while a service
+	// required before use is due.
+	ErrServiceDue = errors.New("equipment: the item is due for a required service")
+	// ErrBadPlan is returned when a maintenance plan has no name or no
+	// interval.
+	ErrBadPlan = errors.New("equipment: the plan needs a name and an interval in days or checkouts")
+	// ErrNoPlan is returned when a maintenance plan does not exist.
+	ErrNoPlan = errors.New("equipment: maintenance plan not found")
+)
+
+// Plan is a periodic maintenance of an item, e.g. a yearly calibration or a
+// check of lifting gear every 20 checkouts. It is due after the number of days
+// or of checkouts since its last service, whichever comes first.
+type Plan struct {
+	ID        string `yaml:"id" json:"id"`
+	Name      string `yaml:"name" json:"name"`
+	Days      int    `yaml:"days,omitempty" json:"days,omitempty"`
+	Checkouts int    `yaml:"checkouts,omitempty" json:"checkouts,omitempty"`
+	// Required plans must be done before the item can be checked out again
+	// once they are due.
+	Required bool `yaml:"required,omitempty" json:"required,omitempty"`
+	// Since is when the plan was added, which counts as its first service.
+	Since time.Time `yaml:"since" json:"since"`
+}
+
+// Service is a record of the maintenance of an item.
+type Service struct {
+	ID   string    `yaml:"id" json:"id"`
+	When time.Time `yaml:"when" json:"when"`
+	// Plan is the ID of the plan serviced, or empty for a repair.
+	Plan  string `yaml:"plan,omitempty" json:"plan,omitempty"`
+	By    string `yaml:"by,omitempty" json:"by,omitempty"`
+	Notes string `yaml:"notes,omitempty" json:"notes,omitempty"`
+	// Attachments are the file names of the documents of the service, e.g.
+	// a calibration certificate, stored under `Dir`.
+	Attachments []string `yaml:"attachments,omitempty" json:"attachments,omitempty"`
+}
+
+// Dir returns the path of the attachments of the service in the directory of
+// the item.
+func (s *Service) Dir() string {
+	return servicesDir + "/" + s.ID
+}
+
+// ServiceDue is the state of a maintenance plan of an item.
+type ServiceDue struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Plan *Plan  `json:"plan"`
+	// Last is when the plan was last serviced, and Next when it is due by
+	// time, if it has an interval in days.
+	Last time.Time  `json:"last"`
+	Next *time.Time `json:"next,omitempty"`
+	// Checkouts is the number of checkouts since the last service.
+	Checkouts int  `json:"checkouts"`
+	Due       bool `json:"due"`
+}
+
+// AddPlan adds a maintenance plan to the item.
+func (i *Item) AddPlan(name string, days, checkouts int, required bool) (*Plan, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || days < 0 || checkouts < 0 || days == 0 && checkouts == 0 {
+		return nil, ErrBadPlan
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return nil, err
+	}
+	p := &Plan{
+		ID:        strconv.FormatInt(time.Now().UnixNano(), 36),
+		Name:      name,
+		Days:      days,
+		Checkouts: checkouts,
+		Required:  required,
+		Since:     time.Now(),
+	}
+	i.Plans = append(i.Plans, p)
+	if err := i.Update(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// RemovePlan removes a maintenance plan of the item. Its services are kept.
+func (i *Item) RemovePlan(id string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return err
+	}
+
+	kept := []*Plan{}
+	for _, p := range i.Plans {
+		if p.ID != id {
+			kept = append(kept, p)
+		}
+	}
+	if len(kept) == len(i.Plans) {
+		return ErrNoPlan
+	}
+	i.Plans = kept
+
+	return i.Update()
+}
+
+// SetOutOfService takes the item out of service for a reason, so it cannot be
+// checked out, or puts it back in service with an empty reason.
+func (i *Item) SetOutOfService(reason string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return err
+	}
+	i.OutOfService = strings.TrimSpace(reason)
+	return i.Update()
+}
+
+// Services returns the maintenance records of the item sorted from the oldest
+// to the newest.
+func (i *Item) Services() ([]*Service, error) {
+	data, err := Store.Read(collection, i.ID, itemServices)
+	if err == storage.ErrNotExist {
+		return []*Service{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("equipment: could not read services: %w", err)
+	}
+
+	services := []*Service{}
+	if err := yaml.Unmarshal(data, &services); err != nil {
+		return nil, fmt.Errorf("equipment: could not parse services: %w", err)
+	}
+	sort.SliceStable(services, func(a, b int) bool {
+		return services[a].When.Before(services[b].When)
+	})
+
+	return services, nil
+}
+
+// RecordService records a maintenance of the item, for one of its plans or
+// for a repair if plan is empty, with the given attachments by file name.
+// Like the history, the records are only ever appended to.
+func (i *Item) RecordService(plan, notes, by string, attachments map[string][]byte) (*Service, error) {
+	if plan != "" {
+		if _, err := i.Plan(plan); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	s := &Service{
+		ID:    strconv.FormatInt(now.UnixNano(), 36),
+		When:  now,
+		Plan:  plan,
+		By:    by,
+		Notes: strings.TrimSpace(notes),
+	}
+	for name, data := range attachments {
+		name = attachmentName(name)
+		if err := Store.Write(collection, i.ID, s.Dir()+"/"+name, data); err != nil {
+			return nil, fmt.Errorf("equipment: could not write attachment: %w", err)
+		}
+		s.Attachments = append(s.Attachments, name)
+	}
+	sort.Strings(s.Attachments)
+
+	data, err := yaml.Marshal([]*Service{s})
+	if err != nil {
+		return nil, fmt.Errorf("equipment: could not marshal service: %w", err)
+	}
+	if err := Store.Append(collection, i.ID, itemServices, data); err != nil {
+		return nil, fmt.Errorf("equipment: could not write service: %w", err)
+	}
+
+	return s, nil
+}
+
+// attachmentName returns a file name that is safe to store, keeping only the
+// base name and replacing the characters other than letters, digits, dots,
+// dashes and underscores.
+func attachmentName(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	name = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, name)
+	if strings.Trim(name, ".") == "" {
+		return "attachment"
+	}
+	return name
+}
+
+// Plan returns the maintenance plan of the item with the given ID.
+func (i *Item) Plan(id string) (*Plan, error) {
+	for _, p := range i.Plans {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return nil, ErrNoPlan
+}
+
+// Maintenance returns the state of each maintenance plan of the item.
+func (i *Item) Maintenance() ([]*ServiceDue, error) {
+	if len(i.Plans) == 0 {
+		return []*ServiceDue{}, nil
+	}
+
+	services, err := i.Services()
+	if err != nil {
+		return nil, err
+	}
+	events, err := i.History()
+	if err != nil {
+		return nil, err
+	}
+
+	dues := []*ServiceDue{}
+	for _, p := range i.Plans {
+		d := &ServiceDue{ID: i.ID, Name: i.Name, Plan: p, Last: p.Since}
+		for _, s := range services {
+			if s.Plan == p.ID && s.When.After(d.Last) {
+				d.Last = s.When
+			}
+		}
+		for _, e := range events {
+			if e.Action == Checkout && e.When.After(d.Last) {
+				d.Checkouts++
+			}
+		}
+
+		if p.Days > 0 {
+			next := d.Last.AddDate(0, 0, p.Days)
+			d.Next = &next
+			d.Due = !time.Now().Before(next)
+		}
+		if p.Checkouts > 0 && d.Checkouts >= p.Checkouts {
+			d.Due = true
+		}
+		dues = append(dues, d)
+	}
+
+	return dues, nil
+}
+
+// NeedsService reports whether the item is out of service or any of its
+// maintenance plans is due.
+func (i *Item) NeedsService() bool {
+	if i.OutOfService != "" {
+		return true
+	}
+	dues, err := i.Maintenance()
+	if err != nil {
+		return false
+	}
+	for _, d := range dues {
+		if d.Due {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckService returns an error if the item cannot be checked out because it
+// is out of service or a required service is due.
+func (i *Item) CheckService() error {
+	if i.OutOfService != "" {
+		return ErrOutOfService
+	}
+	dues, err := i.Maintenance()
+	if err != nil {
+		return err
+	}
+	for _, d := range dues {
+		if d.Due && d.Plan.Required {
+			return ErrServiceDue
+		}
+	}
+	return nil
+}
+
+// DueForService returns the maintenance plans that are due of the items,
+// the longest due first.
+func DueForService(items []*Item) ([]*ServiceDue, error) {
+	due := []*ServiceDue{}
+	for _, i := range items {
+		dues, err := i.Maintenance()
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range dues {
+			if d.Due {
+				due = append(due, d)
+			}
+		}
+	}
+	sort.SliceStable(due, func(a, b int) bool {
+		return due[a].Last.Before(due[b].Last)
+	})
+
+	return due, nil
+}
@@ -0,0 +1,227 @@
+package equipment
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNoWho is returned when a reservation is made for nobody.
+	ErrNoWho = errors.New("equipment: the reservation needs a name")
+	// ErrBadPeriod is returned when a reservation ends before it starts or
+	// is already over.
+	ErrBadPeriod = errors.New("equipment: the reservation must end after it starts and after now")
+	// ErrNoReservation is returned when a reservation does not exist.
+	ErrNoReservation = errors.New("equipment: reservation not found")
+)
+
+// Reservation books an item for someone between two times.
+type Reservation struct {
+	ID    string    `yaml:"id" json:"id"`
+	Who   string    `yaml:"who" json:"who"`
+	Start time.Time `yaml:"start" json:"start"`
+	End   time.Time `yaml:"end" json:"end"`
+	Notes string    `yaml:"notes,omitempty" json:"notes,omitempty"`
+	// By is the user who made the reservation.
+	By string `yaml:"by,omitempty" json:"by,omitempty"`
+}
+
+// Overlaps reports whether the reservation overlaps the period from start to
+// end.
+func (r *Reservation) Overlaps(start, end time.Time) bool {
+	return r.Start.Before(end) && start.Before(r.End)
+}
+
+// For reports whether the reservation is for the given person, ignoring case.
+func (r *Reservation) For(who string) bool {
+	return strings.EqualFold(strings.TrimSpace(r.Who), strings.TrimSpace(who))
+}
+
+// ConflictError is returned when an item is booked for someone else during
+// the period asked for, by a reservation or a loan.
+type ConflictError struct {
+	Who   string
+	Start time.Time
+	End   time.Time
+	// Loan is true when the item is lent during the period.
+	Loan bool
+}
+
+func (e *ConflictError) Error() string {
+	what := "reserved for"
+	if e.Loan {
+		what = "lent to"
+	}
+	return fmt.Sprintf("equipment: the item is %s %s from %s to %s", what, e.Who,
+		e.Start.Format("02/01/06 15:04"), e.End.Format("02/01/06 15:04"))
+}
+
+// Conflict returns the booking of someone else overlapping the period from
+// start to end: a reservation, or the current loan of the item. It returns
+// nil if the item is free for who.
+func (i *Item) Conflict(who string, start, end time.Time) (*ConflictError, error) {
+	for _, r := range i.Reservations {
+		if !r.For(who) && r.Overlaps(start, end) {
+			return &ConflictError{Who: r.Who, Start: r.Start, End: r.End}, nil
+		}
+	}
+
+	l, err := i.CurrentLoan()
+	if err != nil {
+		return nil, err
+	}
+	if l != nil && start.Before(l.Due) {
+		return &ConflictError{Who: l.Who, Start: l.Since, End: l.Due, Loan: true}, nil
+	}
+
+	return nil, nil
+}
+
+// Reserve books the item for someone between start and end. It fails with a
+// `*ConflictError` if the item is already reserved or lent during that time.
+func (i *Item) Reserve(who string, start, end time.Time, notes, by string) (*Reservation, error) {
+	who = strings.TrimSpace(who)
+	switch {
+	case who == "":
+		return nil, ErrNoWho
+	case !start.Before(end) || end.Before(time.Now()):
+		return nil, ErrBadPeriod
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return nil, err
+	}
+	// Someone cannot reserve an item they already have, either.
+	c, err := i.Conflict("", start, end)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		return nil, c
+	}
+
+	r := &Reservation{
+		ID:    strconv.FormatInt(time.Now().UnixNano(), 36),
+		Who:   who,
+		Start: start,
+		End:   end,
+		Notes: strings.TrimSpace(notes),
+		By:    by,
+	}
+	i.Reservations = append(i.Reservations, r)
+	sort.Slice(i.Reservations, func(a, b int) bool {
+		return i.Reservations[a].Start.Before(i.Reservations[b].Start)
+	})
+
+	if err := i.Update(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reservation returns the reservation of the item with the given ID.
+func (i *Item) Reservation(id string) (*Reservation, error) {
+	for _, r := range i.Reservations {
+		if r.ID == id {
+			return r, nil
+		}
+	}
+	return nil, ErrNoReservation
+}
+
+// Cancel removes a reservation of the item.
+func (i *Item) Cancel(id string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := i.reload(); err != nil {
+		return err
+	}
+
+	kept := []*Reservation{}
+	for _, r := range i.Reservations {
+		if r.ID != id {
+			kept = append(kept, r)
+		}
+	}
+	if len(kept) == len(i.Reservations) {
+		return ErrNoReservation
+	}
+	i.Reservations = kept
+
+	return i.Update()
+}
+
+// Upcoming returns the reservations of the item that are not over yet.
+func (i *Item) Upcoming() []*Reservation {
+	now := time.Now()
+	upcoming := []*Reservation{}
+	for _, r := range i.Reservations {
+		if r.End.After(now) {
+			upcoming = append(upcoming, r)
+		}
+	}
+	return upcoming
+}
+
+// Booking is a period the item is reserved or lent, as shown on the calendar.
+type Booking struct {
+	Who   string    `json:"who"`
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+	// Reservation is the ID of the reservation, or empty for a loan.
+	Reservation string `json:"reservation,omitempty"`
+}
+
+// Bookings returns the reservations and loans of the item overlapping the
+// period from start to end, sorted by start. Past loans come from the history
+// of the item, and overdue loans last until now.
+func (i *Item) Bookings(start, end time.Time) ([]*Booking, error) {
+	bookings := []*Booking{}
+	add := func(b *Booking) {
+		if b.Start.Before(end) && start.Before(b.End) {
+			bookings = append(bookings, b)
+		}
+	}
+
+	for _, r := range i.Reservations {
+		add(&Booking{Who: r.Who, Start: r.Start, End: r.End, Reservation: r.ID})
+	}
+
+	events, err := i.History()
+	if err != nil {
+		return nil, err
+	}
+	var out *Event
+	for _, e := range events {
+		switch {
+		case e.Action == Checkout:
+			out = e
+		case e.Action == Return && out != nil:
+			add(&Booking{Who: out.Who, Start: out.When, End: e.When})
+			out = nil
+		}
+	}
+
+	l, err := i.CurrentLoan()
+	if err != nil {
+		return nil, err
+	}
+	if l != nil {
+		b := &Booking{Who: l.Who, Start: l.Since, End: l.Due}
+		if l.Overdue() {
+			b.End = time.Now()
+		}
+		add(b)
+	}
+
+	sort.Slice(bookings, func(a, b int) bool {
+		return bookings[a].Start.Before(bookings[b].Start)
+	})
+	return bookings, nil
+}
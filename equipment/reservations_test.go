@@ -0,0 +1,111 @@
+package equipment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+)
+
+func TestConflict(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	now := time.Now()
+	day := func(n int) time.Time { return now.Add(time.Duration(n) * 24 * time.Hour) }
+	item := &Item{
+		ID:   "drill",
+		Name: "Drill",
+		Reservations: []*Reservation{
+			{ID: "r1", Who: "Lee", Start: day(3), End: day(5)},
+			{ID: "r2", Who: "Sam", Start: day(10), End: day(12)},
+		},
+	}
+	lent := &Item{
+		ID:           "saw",
+		Name:         "Saw",
+		InUse:        true,
+		Location:     "kim",
+		Loan:         &Loan{Who: "kim", Since: day(-1), Due: day(2)},
+		Reservations: []*Reservation{{ID: "r3", Who: "Lee", Start: day(6), End: day(7)}},
+	}
+
+	tests := []struct {
+		name     string
+		item     *Item
+		who      string
+		start    time.Time
+		end      time.Time
+		conflict string
+		loan     bool
+	}{
+		{name: "free before", item: item, who: "kim", start: day(0), end: day(3)},
+		{name: "free between", item: item, who: "kim", start: day(5), end: day(10)},
+		{name: "free after", item: item, who: "kim", start: day(12), end: day(14)},
+		{name: "overlaps start", item: item, who: "kim", start: day(2), end: day(4), conflict: "Lee"},
+		{name: "overlaps end", item: item, who: "kim", start: day(4), end: day(6), conflict: "Lee"},
+		{name: "inside", item: item, who: "kim", start: day(11), end: day(11).Add(time.Hour), conflict: "Sam"},
+		{name: "around", item: item, who: "kim", start: day(9), end: day(13), conflict: "Sam"},
+		{name: "own reservation", item: item, who: "Lee", start: day(3), end: day(5)},
+		{name: "own reservation ignoring case", item: item, who: " lee ", start: day(4), end: day(5)},
+		{name: "own and other", item: item, who: "Lee", start: day(4), end: day(11), conflict: "Sam"},
+		{name: "during loan", item: lent, who: "lee", start: day(1), end: day(4), conflict: "kim", loan: true},
+		{name: "during own loan", item: lent, who: "kim", start: day(1), end: day(4), conflict: "kim", loan: true},
+		{name: "after loan", item: lent, who: "sam", start: day(3), end: day(5)},
+		{name: "after loan reserved", item: lent, who: "sam", start: day(3), end: day(7), conflict: "Lee"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := tt.item.Conflict(tt.who, tt.start, tt.end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch {
+			case tt.conflict == "" && c != nil:
+				t.Errorf("Conflict = %v, want none", c)
+			case tt.conflict != "" && c == nil:
+				t.Errorf("Conflict = nil, want a conflict with %s", tt.conflict)
+			case c != nil && (c.Who != tt.conflict || c.Loan != tt.loan):
+				t.Errorf("Conflict = %+v, want %s (loan %v)", c, tt.conflict, tt.loan)
+			}
+		})
+	}
+}
+
+func TestReserve(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	now := time.Now()
+	item := &Item{ID: "drill", Name: "Drill"}
+	if err := item.Update(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := item.Reserve("", now, now.Add(time.Hour), "", ""); err != ErrNoWho {
+		t.Errorf("Reserve for nobody = %v, want ErrNoWho", err)
+	}
+	if _, err := item.Reserve("Lee", now.Add(time.Hour), now, "", ""); err != ErrBadPeriod {
+		t.Errorf("Reserve ending before it starts = %v, want ErrBadPeriod", err)
+	}
+
+	r, err := item.Reserve("Lee", now.Add(2*time.Hour), now.Add(4*time.Hour), "", "admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := item.Reserve("Lee", now.Add(3*time.Hour), now.Add(5*time.Hour), "", ""); err == nil {
+		t.Error("Reserve accepted a reservation overlapping another")
+	}
+	if _, err := item.Reserve("Sam", now, now.Add(2*time.Hour), "", ""); err != nil {
+		t.Errorf("Reserve right before another = %v, want no error", err)
+	}
+
+	if err := item.Cancel(r.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := item.Cancel(r.ID); err != ErrNoReservation {
+		t.Errorf("Cancel of a cancelled reservation = %v, want ErrNoReservation", err)
+	}
+	if n := len(item.Upcoming()); n != 1 {
+		t.Errorf("Upcoming() has %d reservations, want 1", n)
+	}
+}
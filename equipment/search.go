@@ -0,0 +1,88 @@
+package equipment
+
+import (
+	"sort"
+	"strings"
+)
+
+// Filter selects items of the equipment. Empty fields match every item.
+type Filter struct {
+	// Text is searched in the name, price and location of the items,
+	// ignoring case. Every word must be found for an item to match.
+	Text     string
+	Location string
+	// Within selects the items stored at any of the given location IDs, e.g.
+	// a location and every location inside it.
+	Within map[string]bool
+	// InUse selects the items in use when true and the available items
+	// when false.
+	InUse *bool
+	// Overdue selects the items in use past the due date of their loan.
+	Overdue bool
+	// Service selects the items out of service or due for maintenance.
+	Service bool
+}
+
+// Search returns the items of the equipment matching the filter.
+func Search(f Filter) ([]*Item, error) {
+	items, err := Items()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Apply(items), nil
+}
+
+// Apply returns the items matching the filter, keeping their order.
+func (f Filter) Apply(items []*Item) []*Item {
+	found := []*Item{}
+	for _, i := range items {
+		if i.Matches(f) {
+			found = append(found, i)
+		}
+	}
+
+	return found
+}
+
+// Matches reports whether the item matches the filter.
+func (i *Item) Matches(f Filter) bool {
+	switch {
+	case f.Location != "" && !strings.EqualFold(i.Location, f.Location):
+		return false
+	case f.Within != nil && !f.Within[i.Location]:
+		return false
+	case f.InUse != nil && i.InUse != *f.InUse:
+		return false
+	case f.Overdue && !i.Overdue():
+		return false
+	case f.Service && !i.NeedsService():
+		return false
+	}
+
+	text := strings.ToLower(strings.Join([]string{i.Name, i.Price, i.Location}, "\n"))
+	for _, word := range strings.Fields(strings.ToLower(f.Text)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Locations returns the distinct locations of the items sorted by name. The
+// `ReturnLocation` is not a place, and is left out.
+func Locations(items []*Item) []string {
+	seen := map[string]bool{}
+	locations := []string{}
+	for _, i := range items {
+		if i.Location == "" || i.Location == ReturnLocation || seen[i.Location] {
+			continue
+		}
+		seen[i.Location] = true
+		locations = append(locations, i.Location)
+	}
+	sort.Strings(locations)
+
+	return locations
+}
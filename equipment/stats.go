@@ -0,0 +1,47 @@
+package equipment
+
+import (
+	"sort"
+)
+
+// Stats are the aggregate numbers of the equipment shown on the dashboard.
+type Stats struct {
+	Items int `json:"items"`
+	InUse int `json:"in_use"`
+	// Loans lists the items in use, the first due first, and Overdue the
+	// loans past their due date.
+	Loans   []*Loan `json:"loans"`
+	Overdue []*Loan `json:"overdue"`
+}
+
+// Summarize computes the stats of a list of items.
+func Summarize(items []*Item) (*Stats, error) {
+	s := &Stats{
+		Items:   len(items),
+		Loans:   []*Loan{},
+		Overdue: []*Loan{},
+	}
+
+	for _, i := range items {
+		if !i.InUse {
+			continue
+		}
+		s.InUse++
+
+		loan, err := i.CurrentLoan()
+		if err != nil {
+			return nil, err
+		}
+		s.Loans = append(s.Loans, loan)
+	}
+	sort.Slice(s.Loans, func(a, b int) bool {
+		return s.Loans[a].Due.Before(s.Loans[b].Due)
+	})
+	for _, l := range s.Loans {
+		if l.Overdue() {
+			s.Overdue = append(s.Overdue, l)
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,128 @@
+package inventory
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseBarcodes splits a list of barcodes separated by commas, spaces or new
+// lines, dropping duplicates.
+func ParseBarcodes(s string) []string {
+	seen := map[string]bool{}
+	codes := []string{}
+	for _, code := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ';' || r == ' ' || r == '\t' || r == '\n' || r == '\r'
+	}) {
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// FindBarcode returns the item with the given ID or external barcode. UPC-A
+// codes match the same code written as EAN-13, with a leading zero.
+func FindBarcode(code string) (*Item, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, ErrNotFound
+	}
+	if i, err := Get(code); err == nil {
+		return i, nil
+	}
+
+	items, err := Items()
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range items {
+		if i.HasBarcode(code) {
+			return i, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// HasBarcode reports whether the code is one of the external barcodes of the
+// item.
+func (i *Item) HasBarcode(code string) bool {
+	code = normalBarcode(code)
+	for _, c := range i.Barcodes {
+		if normalBarcode(c) == code {
+			return true
+		}
+	}
+	return false
+}
+
+// EAN13 returns the first EAN-13 or UPC-A barcode of the item, as EAN-13.
+func (i *Item) EAN13() (string, bool) {
+	for _, c := range i.Barcodes {
+		if c = normalBarcode(c); len(c) == 13 && isDigits(c) {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// checkBarcodes validates the check digit of the EAN, UPC and GTIN barcodes,
+// and that no other item already has one of the barcodes.
+func checkBarcodes(id string, codes []string) error {
+	for _, c := range codes {
+		if isDigits(c) && (len(c) == 8 || len(c) == 12 || len(c) == 13 || len(c) == 14) && !validCheckDigit(c) {
+			return fmt.Errorf("%s has a wrong check digit", c)
+		}
+	}
+	if len(codes) == 0 {
+		return nil
+	}
+
+	items, err := Items()
+	if err != nil {
+		return err
+	}
+	for _, i := range items {
+		if i.ID == id {
+			continue
+		}
+		for _, c := range codes {
+			if i.HasBarcode(c) || i.ID == c {
+				return fmt.Errorf("%s is already used by %s", c, i.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// normalBarcode writes UPC-A codes as EAN-13 so both forms match.
+func normalBarcode(code string) string {
+	if len(code) == 12 && isDigits(code) {
+		return "0" + code
+	}
+	return code
+}
+
+// validCheckDigit reports whether the last digit of a GTIN code (EAN-8,
+// UPC-A, EAN-13 or GTIN-14) matches the others.
+func validCheckDigit(code string) bool {
+	sum := 0
+	for n := len(code) - 2; n >= 0; n-- {
+		d := int(code[n] - '0')
+		// The digits are weighted 3 and 1 alternately from the right.
+		if (len(code)-2-n)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10-sum%10)%10 == int(code[len(code)-1]-'0')
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
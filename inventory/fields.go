@@ -0,0 +1,169 @@
+package inventory
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ValidationError is returned when the fields of an item are not valid. It
+// maps the name of each invalid field to the reason it was rejected.
+type ValidationError struct {
+	Fields map[string]string
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	names := make([]string, 0, len(e.Fields))
+	for name := range e.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	msgs := make([]string, 0, len(names))
+	for _, name := range names {
+		msgs = append(msgs, fmt.Sprintf("%s %s", name, e.Fields[name]))
+	}
+	return "inventory: invalid " + strings.Join(msgs, ", ")
+}
+
+// fields holds the numeric fields of an item parsed from text, e.g. from a
+// web form.
+type fields struct {
+	value           Money
+	size            float64
+	quantity        int
+	price           Money
+	reorderPoint    int
+	reorderQuantity int
+}
+
+func parseFields(value, size, quantity, price, reorderPoint, reorderQuantity string) (*fields, error) {
+	f := &fields{}
+	invalid := map[string]string{}
+
+	var err error
+	if f.value, err = ParseMoney(value); err != nil {
+		invalid["value"] = "must be an amount of money"
+	} else if f.value.Amount < 0 {
+		invalid["value"] = "must not be negative"
+	}
+	if f.size, err = parseSize(size); err != nil {
+		invalid["size"] = "must be a number"
+	}
+	if f.quantity, err = parseQuantity(quantity); err != nil {
+		invalid["quantity"] = "must be a whole number"
+	}
+	if f.price, err = ParseMoney(price); err != nil {
+		invalid["price"] = "must be an amount of money"
+	} else if f.price.Amount < 0 {
+		invalid["price"] = "must not be negative"
+	}
+	if f.reorderPoint, err = parseQuantity(reorderPoint); err != nil {
+		invalid["reorder point"] = "must be a whole number"
+	} else if f.reorderPoint < 0 {
+		invalid["reorder point"] = "must not be negative"
+	}
+	if f.reorderQuantity, err = parseQuantity(reorderQuantity); err != nil {
+		invalid["reorder quantity"] = "must be a whole number"
+	} else if f.reorderQuantity < 0 {
+		invalid["reorder quantity"] = "must not be negative"
+	}
+
+	if len(invalid) > 0 {
+		return nil, &ValidationError{Fields: invalid}
+	}
+	return f, nil
+}
+
+func parseSize(s string) (float64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseQuantity(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(s)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. Items written
+// before the numeric fields were introduced stored them as text; those values
+// are converted on read, and any that cannot be converted are kept in
+// `Legacy` instead of being dropped.
+func (i *Item) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var doc yaml.MapSlice
+	if err := unmarshal(&doc); err != nil {
+		return err
+	}
+
+	legacy := map[string]string{}
+	migrated := doc[:0]
+	for _, kv := range doc {
+		key, _ := kv.Key.(string)
+		if !isNumericField(key) || !isScalar(kv.Value) {
+			migrated = append(migrated, kv)
+			continue
+		}
+
+		text := fmt.Sprint(kv.Value)
+		var v interface{}
+		var err error
+		switch key {
+		case "value", "price":
+			v, err = ParseMoney(text)
+		case "size":
+			v, err = parseSize(text)
+		case "quantity":
+			v, err = parseQuantity(text)
+		}
+		if err != nil {
+			legacy[key] = text
+			continue
+		}
+		migrated = append(migrated, yaml.MapItem{Key: key, Value: v})
+	}
+
+	data, err := yaml.Marshal(migrated)
+	if err != nil {
+		return err
+	}
+	type plain Item
+	if err := yaml.Unmarshal(data, (*plain)(i)); err != nil {
+		return err
+	}
+
+	if len(legacy) > 0 {
+		if i.Legacy == nil {
+			i.Legacy = map[string]string{}
+		}
+		for k, v := range legacy {
+			i.Legacy[k] = v
+		}
+	}
+	return nil
+}
+
+func isNumericField(key string) bool {
+	switch key {
+	case "value", "price", "size", "quantity":
+		return true
+	}
+	return false
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case nil, yaml.MapSlice, map[interface{}]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
@@ -3,91 +3,43 @@ package inventory
 import (
 	"bytes"
 	"encoding/base64"
+	"errors"
 	"fmt"
-	"io/ioutil"
-	"os"
-	"path/filepath"
 	"regexp"
 	"strings"
-	"sync"
 
-	"github.com/mitchellh/go-homedir"
+	"github.com/medoix/warehouse/storage"
 	"gopkg.in/yaml.v2"
 )
 
 var (
-	// CustomPath sets a custom directory to store the inventory.
-	CustomPath string
+	// Store is the storage backend of the inventory (default: the
+	// ~/.warehouse directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
 	// ReturnLocation sets the return location of the inventory (default:
 	// returned).
 	ReturnLocation = "returned"
+	// ErrNotFound is returned when an item does not exist in the inventory.
+	ErrNotFound = errors.New("inventory: item not found")
 )
 
 // Items returns the list of items in the inventory.
 func Items() ([]*Item, error) {
-	path := getDir()
-	itemsDirs, err := ioutil.ReadDir(path)
-	if os.IsNotExist(err) {
-		fmt.Println("looks like", path, "does not exist, I will create it")
-		err = os.MkdirAll(path, os.ModePerm)
-		if err != nil {
-			return nil, fmt.Errorf("inventory: could not create directory: %w", err)
-		}
-		fmt.Println("done!")
-	} else if err != nil {
-		return nil, fmt.Errorf("inventory: could not read directory: %w", err)
+	files, err := Store.ReadAll(collection, itemYAML)
+	if err != nil {
+		return nil, fmt.Errorf("inventory: could not read items: %w", err)
 	}
 
-	var readers sync.WaitGroup
-	var managers sync.WaitGroup
-
 	items := []*Item{}
-	queue := make(chan *Item)
-	errors := make(chan error)
-
-	managers.Add(1)
-	go func() {
-		defer managers.Done()
-		for i := range queue {
-			items = append(items, i)
-		}
-	}()
-
-	managers.Add(1)
-	go func() {
-		defer managers.Done()
-		for e := range errors {
-			err = fmt.Errorf("%v\n%w", err, e)
+	for _, data := range files {
+		var i Item
+		if e := yaml.Unmarshal(data, &i); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("inventory: could not parse item: %w", e))
+			continue
 		}
-	}()
-
-	readers.Add(len(itemsDirs))
-	for _, dir := range itemsDirs {
-		dir := dir
-		go func() {
-			defer readers.Done()
-			if dir.IsDir() {
-				yamlFile := filepath.Join(path, dir.Name(), itemYAML)
-				data, err := ioutil.ReadFile(yamlFile)
-				if err != nil {
-					errors <- fmt.Errorf("inventory: could not parse item: %w", err)
-					return
-				}
-				var i Item
-				if err := yaml.Unmarshal(data, &i); err != nil {
-					errors <- fmt.Errorf("inventory: could not parse item: %w", err)
-					return
-				}
-				queue <- &i
-			}
-		}()
+		items = append(items, &i)
 	}
 
-	readers.Wait()
-	close(queue)
-	close(errors)
-	managers.Wait()
-
 	return items, err
 }
 
@@ -103,18 +55,30 @@ func SortedItems(by sortBy, reversed bool) ([]*Item, error) {
 }
 
 // Add adds a new named item to the inventory. It will auto-generate a unique
-// ID for the item based on the name.
-func Add(sku, name, itemtype, value, size, quantity, price, location string) (*Item, error) {
+// ID for the item based on the name. Numeric fields that cannot be parsed and
+// invalid or duplicate barcodes are reported with a `*ValidationError`.
+func Add(sku, name, itemtype, value, size, quantity, price, location, reorderPoint, reorderQuantity, barcodes string) (*Item, error) {
+	f, err := parseFields(value, size, quantity, price, reorderPoint, reorderQuantity)
+	if err != nil {
+		return nil, err
+	}
+	codes := ParseBarcodes(barcodes)
+	if err := checkBarcodes("", codes); err != nil {
+		return nil, &ValidationError{Fields: map[string]string{"barcodes": err.Error()}}
+	}
 	item := &Item{
-		ID:       uniqueKey(name),
-		SKU:      sku,
-		Name:     name,
-		Type:     itemtype,
-		Value:    value,
-		Size:     size,
-		Quantity: quantity,
-		Price:    price,
-		Location: location,
+		ID:              uniqueKey(name),
+		SKU:             sku,
+		Name:            name,
+		Type:            itemtype,
+		Value:           f.value,
+		Size:            f.size,
+		Quantity:        f.quantity,
+		Price:           f.price,
+		Location:        location,
+		ReorderPoint:    f.reorderPoint,
+		ReorderQuantity: f.reorderQuantity,
+		Barcodes:        codes,
 	}
 
 	img, err := base64.StdEncoding.DecodeString(imgDEFAULT)
@@ -133,27 +97,91 @@ func Add(sku, name, itemtype, value, size, quantity, price, location string) (*I
 	if err != nil {
 		return nil, fmt.Errorf("inventory: could not add item: %w", err)
 	}
+	_, err = item.reconcile("initial stock")
+	if err != nil {
+		return nil, fmt.Errorf("inventory: could not add item: %w", err)
+	}
+
+	return item, nil
+}
+
+// Get returns the item of the inventory with the given ID.
+func Get(id string) (*Item, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
+	}
+
+	item := &Item{ID: id}
+	data, err := Store.Read(collection, id, itemYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("inventory: could not read item: %w", err)
+	}
+	if err := yaml.Unmarshal(data, item); err != nil {
+		return nil, fmt.Errorf("inventory: could not parse item: %w", err)
+	}
 
 	return item, nil
 }
 
-// Update updates an item in the inventory by ID.
-func Update(id, sku, name, itemtype, value, size, quantity, price, location string) (*Item, error) {
+// Update updates an item in the inventory by ID. A change of quantity is
+// recorded in the ledger of the item as an adjustment. Numeric fields that
+// cannot be parsed and invalid or duplicate barcodes are reported with a
+// `*ValidationError`.
+func Update(id, sku, name, itemtype, value, size, quantity, price, location, reorderPoint, reorderQuantity, barcodes string) (*Item, error) {
+	f, err := parseFields(value, size, quantity, price, reorderPoint, reorderQuantity)
+	if err != nil {
+		return nil, err
+	}
+	codes := ParseBarcodes(barcodes)
+	if err := checkBarcodes(id, codes); err != nil {
+		return nil, &ValidationError{Fields: map[string]string{"barcodes": err.Error()}}
+	}
 	item := &Item{
-		ID:       id,
-		SKU:      sku,
-		Name:     name,
-		Type:     itemtype,
-		Value:    value,
-		Size:     size,
-		Quantity: quantity,
-		Price:    price,
-		Location: location,
-	}
-
-	err := item.Update()
+		ID:              id,
+		SKU:             sku,
+		Name:            name,
+		Type:            itemtype,
+		Value:           f.value,
+		Size:            f.size,
+		Quantity:        f.quantity,
+		Price:           f.price,
+		Location:        location,
+		ReorderPoint:    f.reorderPoint,
+		ReorderQuantity: f.reorderQuantity,
+		Barcodes:        codes,
+	}
+
+	// Items created before the ledger existed get their previous quantity
+	// recorded first, so the edit below shows up as the actual difference.
+	old, err := Get(id)
+	if err == nil {
+		if _, err := old.reconcile("opening balance"); err != nil {
+			return nil, fmt.Errorf("inventory: could not update item: %w", err)
+		}
+		item.Suppliers = old.Suppliers
+		item.ReorderAlerted = old.ReorderAlerted
+		// Legacy values are kept until a new value is entered for them.
+		entered := map[string]string{"value": value, "size": size, "quantity": quantity, "price": price}
+		for k, v := range old.Legacy {
+			if strings.TrimSpace(entered[k]) != "" {
+				continue
+			}
+			if item.Legacy == nil {
+				item.Legacy = map[string]string{}
+			}
+			item.Legacy[k] = v
+		}
+	}
+
+	err = item.Update()
 	if err != nil {
-		return nil, fmt.Errorf("inventory: could not add item: %w", err)
+		return nil, fmt.Errorf("inventory: could not update item: %w", err)
+	}
+	_, err = item.reconcile("manual edit")
+	if err != nil {
+		return nil, fmt.Errorf("inventory: could not update item: %w", err)
 	}
 
 	return item, nil
@@ -172,30 +200,15 @@ func Delete(id string) (error) {
 	return err
 }
 
-func getDir() string {
-	if CustomPath != "" {
-		CustomPath += "/inventory"
-		return CustomPath
-	}
-	home, err := homedir.Dir()
-	if err != nil {
-		panic(err)
-	}
-	return filepath.Join(home, ".warehouse/inventory")
-}
-
-// Path returns the path of the inventory.
-func Path() string {
-	return getDir()
-}
-
 func uniqueKey(key string) string {
-	path := getDir()
 	mark := 'a'
 	key = fmt.Sprintf("%.10s", clean(key))
+	if key == "" {
+		key = "item"
+	}
 	valid := key
 
-	for _, err := os.Stat(filepath.Join(path, valid)); !os.IsNotExist(err); _, err = os.Stat(filepath.Join(path, valid)) {
+	for exists(valid) {
 		valid = fmt.Sprintf("%s_%s", key, string(mark))
 		mark++
 	}
@@ -203,6 +216,11 @@ func uniqueKey(key string) string {
 	return valid
 }
 
+func exists(key string) bool {
+	ok, err := Store.Exists(collection, key)
+	return ok || err != nil
+}
+
 func clean(s string) string {
 	rx, err := regexp.Compile("[^[:alnum:][:space:]]+")
 	if err != nil {
@@ -1,13 +1,11 @@
 package inventory
 
 import (
+	"bytes"
 	"fmt"
 	"image"
 	"image/jpeg"
 	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
 	"sort"
 	"time"
 
@@ -17,6 +15,7 @@ import (
 )
 
 const (
+	collection = "inventory"
 	itemYAML   = "info.yaml"
 	itemPic    = "picture.jpg"
 	itemLocPic = "location.jpg"
@@ -25,21 +24,37 @@ const (
 
 // Item is the item in the inventory.
 type Item struct {
-	ID       string    `yaml:"id"`
-	SKU      string    `yaml:"sku"`
-	Name     string    `yaml:"name"`
-	Type     string    `yaml:"itemtype"`
-	Value    string    `yaml:"value"`
-	Size     string    `yaml:"size"`
-	Quantity string    `yaml:"quantity"`
-	Price    string    `yaml:"price"`
-	Location string    `yaml:"location"`
-	Updated  time.Time `yaml:"update"`
-}
-
-// Delete deletes the item from the disk
+	ID       string    `yaml:"id" json:"id"`
+	SKU      string    `yaml:"sku" json:"sku"`
+	Name     string    `yaml:"name" json:"name"`
+	Type     string    `yaml:"itemtype" json:"type"`
+	Value    Money     `yaml:"value" json:"value"`
+	Size     float64   `yaml:"size" json:"size"`
+	Quantity int       `yaml:"quantity" json:"quantity"`
+	Price    Money     `yaml:"price" json:"price"`
+	Location string    `yaml:"location" json:"location"`
+	Updated  time.Time `yaml:"update" json:"updated"`
+	// ReorderPoint is the quantity at or below which the item needs to be
+	// reordered, and ReorderQuantity how much to reorder. A reorder point
+	// of zero disables reorder alerts for the item.
+	ReorderPoint    int `yaml:"reorder_point,omitempty" json:"reorder_point"`
+	ReorderQuantity int `yaml:"reorder_quantity,omitempty" json:"reorder_quantity"`
+	// ReorderAlerted is set once the reorder alert of the item was sent, so
+	// it is only sent again after the item is restocked.
+	ReorderAlerted bool `yaml:"reorder_alerted,omitempty" json:"-"`
+	// Barcodes are the external barcodes of the item, e.g. the EAN-13 or
+	// UPC-A barcode of the manufacturer, so scanning them finds the item.
+	Barcodes []string `yaml:"barcodes,omitempty" json:"barcodes,omitempty"`
+	// Suppliers links the item to the suppliers it can be bought from.
+	Suppliers []SupplierLink `yaml:"suppliers,omitempty" json:"suppliers,omitempty"`
+	// Legacy keeps the values of older items that could not be converted to
+	// their numeric fields, so they can be fixed by hand.
+	Legacy map[string]string `yaml:"legacy,omitempty" json:"legacy,omitempty"`
+}
+
+// Delete deletes the item from the store.
 func (i *Item) Delete() error {
-	err := os.RemoveAll(i.path(""))
+	err := Store.Delete(collection, i.ID)
 	if err != nil {
 		return fmt.Errorf("inventory: could not delete item directory: %w", err)
 	}
@@ -47,36 +62,42 @@ func (i *Item) Delete() error {
 	return nil
 }
 
-// Update updates the information of the item on disk.
+// Update updates the information of the item in the store. The
+// `ReorderAlert` is called when the quantity of the item falls to its reorder
+// point.
 func (i *Item) Update() error {
 	i.Updated = time.Now()
-
-	err := os.MkdirAll(i.path(""), os.ModePerm)
-	if err != nil {
-		return fmt.Errorf("inventory: could not create item directory: %w", err)
-	}
+	alert := i.BelowReorderPoint() && !i.ReorderAlerted
+	i.ReorderAlerted = i.BelowReorderPoint()
 
 	data, err := yaml.Marshal(i)
 	if err != nil {
 		return fmt.Errorf("inventory: could not marshal yaml file: %w", err)
 	}
-	ioutil.WriteFile(i.path(itemYAML), data, 0644)
+
+	if err := Store.Write(collection, i.ID, itemYAML, data); err != nil {
+		return fmt.Errorf("inventory: could not write item: %w", err)
+	}
+	if alert && ReorderAlert != nil {
+		ReorderAlert(i)
+	}
+
 	return nil
 }
 
 // SetPicture sets the thumbnail picture of the item. To save space, the image
 // is resized within 1000x1000 pixels and encoded as jpeg.
 func (i *Item) SetPicture(r io.ReadSeeker) error {
-	return parseImg(r, 1000, i.path(itemPic))
+	return i.setImg(r, 1000, itemPic)
 }
 
 // SetLocationPicture sets the thumbnail picture of the item. To save space, the image
 // is resized within 1000x1000 pixels and encoded as jpeg.
 func (i *Item) SetLocationPicture(r io.ReadSeeker) error {
-	return parseImg(r, 1500, i.path(itemLocPic))
+	return i.setImg(r, 1500, itemLocPic)
 }
 
-func parseImg(r io.ReadSeeker, size int, filepath string) error {
+func (i *Item) setImg(r io.ReadSeeker, size int, name string) error {
 	data, _, err := exiffix.Decode(r)
 	if err != nil {
 		return fmt.Errorf("inventory: could not decode image: %w", err)
@@ -84,37 +105,35 @@ func parseImg(r io.ReadSeeker, size int, filepath string) error {
 
 	img := imaging.Thumbnail(data, size, size, imaging.Lanczos)
 
-	file, err := os.Create(filepath)
-	if err != nil {
-		return fmt.Errorf("inventory: could not create image file: %w", err)
-	}
-	defer file.Close()
-	err = jpeg.Encode(file, img, nil)
+	var buf bytes.Buffer
+	err = jpeg.Encode(&buf, img, nil)
 	if err != nil {
 		return fmt.Errorf("inventory: could not encode image file: %w", err)
 	}
+	if err := Store.Write(collection, i.ID, name, buf.Bytes()); err != nil {
+		return fmt.Errorf("inventory: could not create image file: %w", err)
+	}
 
 	return nil
 }
 
 // Picture returns the picture associated with the item.
 func (i *Item) Picture() (image.Image, error) {
-	return getImg(i.path(itemPic))
+	return i.getImg(itemPic)
 }
 
 // LocationPicture returns the picture of the location associated with the item.
 func (i *Item) LocationPicture() (image.Image, error) {
-	return getImg(i.path(itemLocPic))
+	return i.getImg(itemLocPic)
 }
 
-func getImg(filepath string) (image.Image, error) {
-	file, err := os.Open(filepath)
+func (i *Item) getImg(name string) (image.Image, error) {
+	data, err := Store.Read(collection, i.ID, name)
 	if err != nil {
 		return nil, fmt.Errorf("inventory: could not open image file: %w", err)
 	}
-	defer file.Close()
 
-	img, err := jpeg.Decode(file)
+	img, err := jpeg.Decode(bytes.NewReader(data))
 	if err != nil {
 		return nil, fmt.Errorf("inventory: could not decode image: %w", err)
 	}
@@ -122,13 +141,6 @@ func getImg(filepath string) (image.Image, error) {
 	return img, nil
 }
 
-func (i *Item) path(filename string) string {
-	if filename == "" {
-		return filepath.Join(getDir(), i.ID)
-	}
-	return filepath.Join(getDir(), i.ID, filename)
-}
-
 // by is the type of the `Less` function.
 type by func(i1, i2 *Item) bool
 
@@ -187,7 +199,7 @@ func Sort(element sortBy, items []*Item, reversed bool) {
 		}).sorter(items, reversed)
 	case ByPrice:
 		by(func(i1, i2 *Item) bool {
-			return i1.Price < i2.Price
+			return i1.Price.Amount < i2.Price.Amount
 		}).sorter(items, reversed)
 	}
 }
@@ -0,0 +1,191 @@
+package inventory
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+const itemLedger = "ledger.yaml"
+
+// Kind is the kind of a stock movement.
+type Kind string
+
+const (
+	// Receive adds stock that arrived at the warehouse.
+	Receive Kind = "receive"
+	// Pick removes stock taken out for use or sale.
+	Pick Kind = "pick"
+	// Adjust corrects the stock, e.g. after a stock-take.
+	Adjust Kind = "adjust"
+	// WriteOff removes damaged, lost or expired stock.
+	WriteOff Kind = "write-off"
+	// Transfer moves stock between the item location and another location.
+	Transfer Kind = "transfer"
+	// Relocate moves the whole item to another location, e.g. by scanning
+	// it and then a bin. It does not change the stock and is only recorded
+	// by `Relocate`.
+	Relocate Kind = "relocate"
+)
+
+// Kinds lists the kinds of stock movements that can be recorded by hand.
+var Kinds = []Kind{Receive, Pick, Adjust, WriteOff, Transfer}
+
+// Movement is an entry of the stock ledger of an item. Quantities are signed:
+// positive movements add stock and negative movements remove it.
+type Movement struct {
+	Kind     Kind      `yaml:"kind" json:"kind"`
+	Quantity int       `yaml:"quantity" json:"quantity"`
+	Reason   string    `yaml:"reason" json:"reason"`
+	Location string    `yaml:"location,omitempty" json:"location,omitempty"`
+	When     time.Time `yaml:"when" json:"when"`
+}
+
+// Ledger returns the stock movements of the item sorted from the oldest to
+// the newest.
+func (i *Item) Ledger() ([]*Movement, error) {
+	data, err := Store.Read(collection, i.ID, itemLedger)
+	if err == storage.ErrNotExist {
+		return []*Movement{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("inventory: could not read ledger: %w", err)
+	}
+
+	movements := []*Movement{}
+	if err := yaml.Unmarshal(data, &movements); err != nil {
+		return nil, fmt.Errorf("inventory: could not parse ledger: %w", err)
+	}
+	sort.SliceStable(movements, func(a, b int) bool {
+		return movements[a].When.Before(movements[b].When)
+	})
+
+	return movements, nil
+}
+
+// Balance returns the quantity of the item according to its ledger.
+func (i *Item) Balance() (int, error) {
+	movements, err := i.Ledger()
+	if err != nil {
+		return 0, err
+	}
+
+	balance := 0
+	for _, m := range movements {
+		balance += m.Quantity
+	}
+
+	return balance, nil
+}
+
+// Move records a stock movement in the ledger of the item and updates its
+// quantity. Receipts must be positive, picks and write-offs negative.
+// Transfers take the other location involved in the movement.
+func (i *Item) Move(kind Kind, quantity int, reason, location string) (*Movement, error) {
+	if err := validMove(kind, quantity); err != nil {
+		return nil, err
+	}
+	if _, err := i.reconcile("opening balance"); err != nil {
+		return nil, err
+	}
+
+	m := &Movement{
+		Kind:     kind,
+		Quantity: quantity,
+		Reason:   reason,
+		Location: location,
+		When:     time.Now(),
+	}
+	if err := i.append(m); err != nil {
+		return nil, err
+	}
+
+	i.Quantity += quantity
+	if err := i.Update(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Relocate moves the item to another location and records the move in its
+// ledger, with the location it was moved to.
+func (i *Item) Relocate(location, reason string) (*Movement, error) {
+	if location == i.Location {
+		return nil, fmt.Errorf("inventory: the item is already at that location")
+	}
+	if _, err := i.reconcile("opening balance"); err != nil {
+		return nil, err
+	}
+
+	m := &Movement{
+		Kind:     Relocate,
+		Reason:   reason,
+		Location: location,
+		When:     time.Now(),
+	}
+	if err := i.append(m); err != nil {
+		return nil, err
+	}
+
+	i.Location = location
+	if err := i.Update(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// Reconcile compares the quantity of the item against its ledger and records
+// an adjustment for the difference, if any. It returns the adjustment.
+func (i *Item) Reconcile() (*Movement, error) {
+	return i.reconcile("reconciliation")
+}
+
+func (i *Item) reconcile(reason string) (*Movement, error) {
+	balance, err := i.Balance()
+	if err != nil {
+		return nil, err
+	}
+	if i.Quantity == balance {
+		return nil, nil
+	}
+
+	m := &Movement{
+		Kind:     Adjust,
+		Quantity: i.Quantity - balance,
+		Reason:   reason,
+		When:     time.Now(),
+	}
+
+	return m, i.append(m)
+}
+
+func (i *Item) append(m *Movement) error {
+	data, err := yaml.Marshal([]*Movement{m})
+	if err != nil {
+		return fmt.Errorf("inventory: could not marshal ledger: %w", err)
+	}
+	if err := Store.Append(collection, i.ID, itemLedger, data); err != nil {
+		return fmt.Errorf("inventory: could not write ledger: %w", err)
+	}
+
+	return nil
+}
+
+func validMove(kind Kind, quantity int) error {
+	switch {
+	case quantity == 0:
+		return fmt.Errorf("inventory: a %s needs a quantity", kind)
+	case kind == Receive && quantity < 0:
+		return fmt.Errorf("inventory: a %s must add stock", kind)
+	case (kind == Pick || kind == WriteOff) && quantity > 0:
+		return fmt.Errorf("inventory: a %s must remove stock", kind)
+	case kind == Transfer || kind == Adjust || kind == Receive || kind == Pick || kind == WriteOff:
+		return nil
+	}
+
+	return fmt.Errorf("inventory: unknown stock movement %q", kind)
+}
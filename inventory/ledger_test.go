@@ -0,0 +1,99 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/medoix/warehouse/storage"
+)
+
+func TestValidMove(t *testing.T) {
+	tests := []struct {
+		kind     Kind
+		quantity int
+		valid    bool
+	}{
+		{Receive, 5, true},
+		{Receive, -5, false},
+		{Pick, -2, true},
+		{Pick, 2, false},
+		{WriteOff, -1, true},
+		{WriteOff, 1, false},
+		{Adjust, 3, true},
+		{Adjust, -3, true},
+		{Transfer, -4, true},
+		{Transfer, 0, false},
+		{Relocate, 1, false},
+		{Kind("steal"), -1, false},
+	}
+
+	for _, tt := range tests {
+		err := validMove(tt.kind, tt.quantity)
+		if valid := err == nil; valid != tt.valid {
+			t.Errorf("validMove(%s, %d) = %v, want valid %v", tt.kind, tt.quantity, err, tt.valid)
+		}
+	}
+}
+
+func TestLedger(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	// An item from before the ledger gets its quantity as opening balance.
+	item := &Item{ID: "bolt", Name: "Bolt", Quantity: 10}
+	if err := item.Update(); err != nil {
+		t.Fatal(err)
+	}
+
+	moves := []struct {
+		kind     Kind
+		quantity int
+		want     int
+	}{
+		{Receive, 5, 15},
+		{Pick, -3, 12},
+		{WriteOff, -2, 10},
+		{Adjust, 1, 11},
+	}
+	for _, m := range moves {
+		if _, err := item.Move(m.kind, m.quantity, "test", ""); err != nil {
+			t.Fatalf("Move(%s, %d) returned %v", m.kind, m.quantity, err)
+		}
+		if item.Quantity != m.want {
+			t.Errorf("after %s of %d the quantity is %d, want %d", m.kind, m.quantity, item.Quantity, m.want)
+		}
+	}
+	if _, err := item.Move(Pick, 1, "test", ""); err == nil {
+		t.Error("Move accepted a positive pick")
+	}
+
+	movements, err := item.Ledger()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movements) != 5 {
+		t.Fatalf("the ledger has %d movements, want 5", len(movements))
+	}
+	if m := movements[0]; m.Kind != Adjust || m.Quantity != 10 || m.Reason != "opening balance" {
+		t.Errorf("the first movement is %+v, want the opening balance of 10", m)
+	}
+
+	balance, err := item.Balance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance != item.Quantity {
+		t.Errorf("Balance() = %d, want %d", balance, item.Quantity)
+	}
+
+	// A quantity changed outside the ledger is reconciled once.
+	item.Quantity = 8
+	m, err := item.Reconcile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m == nil || m.Kind != Adjust || m.Quantity != -3 {
+		t.Errorf("Reconcile() = %+v, want an adjustment of -3", m)
+	}
+	if m, err := item.Reconcile(); err != nil || m != nil {
+		t.Errorf("Reconcile() again = %+v, %v, want nothing to adjust", m, err)
+	}
+}
@@ -0,0 +1,131 @@
+package inventory
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the currency used for amounts of money that do not
+// specify one (default: AUD).
+var DefaultCurrency = "AUD"
+
+// minorUnits lists the currencies that do not use two decimal places.
+var minorUnits = map[string]int{
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"BHD": 3,
+	"KWD": 3,
+	"OMR": 3,
+}
+
+// Money is an amount of money stored in the minor units (e.g. cents) of its
+// currency to avoid rounding errors.
+type Money struct {
+	Amount   int64  `yaml:"amount" json:"amount"`
+	Currency string `yaml:"currency" json:"currency"`
+}
+
+// ParseMoney parses an amount of money such as "12.50", "$1,200" or
+// "12.50 AUD". Amounts without currency use the `DefaultCurrency`.
+func ParseMoney(s string) (Money, error) {
+	return ParseMoneyIn(s, DefaultCurrency)
+}
+
+// ParseMoneyIn parses an amount of money like `ParseMoney`, using the given
+// currency for amounts without one, e.g. the currency of a supplier.
+func ParseMoneyIn(s, currency string) (Money, error) {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	m := Money{Currency: currency}
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return m, nil
+	}
+
+	fields := strings.Fields(s)
+	if len(fields) == 2 {
+		switch {
+		case isCurrency(fields[0]):
+			m.Currency, s = strings.ToUpper(fields[0]), fields[1]
+		case isCurrency(fields[1]):
+			m.Currency, s = strings.ToUpper(fields[1]), fields[0]
+		default:
+			return m, fmt.Errorf("inventory: %q is not an amount of money", s)
+		}
+	}
+
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+	s = strings.TrimLeft(s, "$€£¥")
+	s = strings.Replace(s, ",", "", -1)
+
+	digits := m.digits()
+	whole, frac := s, ""
+	if dot := strings.Index(s, "."); dot >= 0 {
+		whole, frac = s[:dot], s[dot+1:]
+	}
+	if whole == "" && frac == "" {
+		return m, fmt.Errorf("inventory: %q is not an amount of money", s)
+	}
+	if len(frac) > digits {
+		return m, fmt.Errorf("inventory: %q has more than %d decimals", s, digits)
+	}
+	frac += strings.Repeat("0", digits-len(frac))
+
+	amount, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil || strings.ContainsAny(whole+frac, "+-") {
+		return m, fmt.Errorf("inventory: %q is not an amount of money", s)
+	}
+	if negative {
+		amount = -amount
+	}
+	m.Amount = amount
+
+	return m, nil
+}
+
+// String implements the Stringer interface.
+func (m Money) String() string {
+	digits := m.digits()
+	if digits == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign, amount := "", m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	unit := int64(1)
+	for n := 0; n < digits; n++ {
+		unit *= 10
+	}
+
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/unit, digits, amount%unit, m.Currency)
+}
+
+// Times returns the amount multiplied by n, e.g. the value of n items.
+func (m Money) Times(n int) Money {
+	return Money{Amount: m.Amount * int64(n), Currency: m.Currency}
+}
+
+func (m Money) digits() int {
+	if d, ok := minorUnits[m.Currency]; ok {
+		return d
+	}
+	return 2
+}
+
+func isCurrency(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, c := range s {
+		if (c < 'A' || c > 'Z') && (c < 'a' || c > 'z') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,117 @@
+package inventory
+
+import (
+	"testing"
+
+	"github.com/medoix/warehouse/storage"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Money
+		wantErr bool
+	}{
+		{in: "", want: Money{0, "AUD"}},
+		{in: "12.50", want: Money{1250, "AUD"}},
+		{in: "12.5", want: Money{1250, "AUD"}},
+		{in: "  7 ", want: Money{700, "AUD"}},
+		{in: "$1,200", want: Money{120000, "AUD"}},
+		{in: "-3.05", want: Money{-305, "AUD"}},
+		{in: "12.50 USD", want: Money{1250, "USD"}},
+		{in: "eur 3", want: Money{300, "EUR"}},
+		{in: "1000 JPY", want: Money{1000, "JPY"}},
+		{in: "1.234 KWD", want: Money{1234, "KWD"}},
+		{in: "1.999", wantErr: true},
+		{in: "1.5 JPY", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "+5", wantErr: true},
+		{in: ".", wantErr: true},
+		{in: "$", wantErr: true},
+		{in: "12 dollars", wantErr: true},
+		{in: "1 2 3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMoney(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseMoney(%q) = %v, want an error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) returned %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMoney(%q) = %#v, want %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMoneyIn(t *testing.T) {
+	got, err := ParseMoneyIn("4.20", "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (Money{420, "USD"}); got != want {
+		t.Errorf("ParseMoneyIn = %#v, want %#v", got, want)
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		in   Money
+		want string
+	}{
+		{Money{1250, "AUD"}, "12.50 AUD"},
+		{Money{5, "AUD"}, "0.05 AUD"},
+		{Money{-5, "AUD"}, "-0.05 AUD"},
+		{Money{1000, "JPY"}, "1000 JPY"},
+		{Money{1234, "KWD"}, "1.234 KWD"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("%#v.String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUpdateKeepsLegacy(t *testing.T) {
+	Store = storage.NewDir(t.TempDir())
+
+	old := []byte("id: bolt\nname: Bolt\nprice: ask Bob\nsize: large\nquantity: 3\n")
+	if err := Store.Write(collection, "bolt", itemYAML, old); err != nil {
+		t.Fatal(err)
+	}
+	item, err := Get("bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Legacy["price"] != "ask Bob" || item.Legacy["size"] != "large" {
+		t.Fatalf("Legacy = %v, want the price and size kept", item.Legacy)
+	}
+
+	// The price is entered again, the size is left empty.
+	item, err = Update("bolt", "", "Bolt", "", "", "", "3", "2.50", "", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := item.Legacy["price"]; ok {
+		t.Errorf("Legacy = %v, want the price replaced", item.Legacy)
+	}
+	if item.Legacy["size"] != "large" {
+		t.Errorf("Legacy = %v, want the size kept", item.Legacy)
+	}
+
+	item, err = Get("bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Legacy["size"] != "large" {
+		t.Errorf("stored Legacy = %v, want the size kept", item.Legacy)
+	}
+}
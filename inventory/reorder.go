@@ -0,0 +1,12 @@
+package inventory
+
+// ReorderAlert is called once when the quantity of an item falls to or below
+// its reorder point. It is called again only after the item was restocked
+// above its reorder point (default: nil).
+var ReorderAlert func(i *Item)
+
+// BelowReorderPoint reports whether the item has a reorder point and its
+// quantity is at or below it.
+func (i *Item) BelowReorderPoint() bool {
+	return i.ReorderPoint > 0 && i.Quantity <= i.ReorderPoint
+}
@@ -0,0 +1,111 @@
+package inventory
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LowStockLevel is the quantity at or below which an item without a reorder
+// point is low on stock (default: 5).
+var LowStockLevel = 5
+
+// Filter selects items of the inventory. Empty fields match every item.
+type Filter struct {
+	// Text is searched in the name, SKU, type, location, size and barcodes
+	// of the items, ignoring case. Every word must be found for an item to match.
+	Text     string
+	Type     string
+	Location string
+	// Within selects the items stored at any of the given location IDs, e.g.
+	// a location and every location inside it.
+	Within   map[string]bool
+	LowStock bool
+}
+
+// Search returns the items of the inventory matching the filter.
+func Search(f Filter) ([]*Item, error) {
+	items, err := Items()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Apply(items), nil
+}
+
+// Apply returns the items matching the filter, keeping their order.
+func (f Filter) Apply(items []*Item) []*Item {
+	found := []*Item{}
+	for _, i := range items {
+		if i.Matches(f) {
+			found = append(found, i)
+		}
+	}
+
+	return found
+}
+
+// Matches reports whether the item matches the filter.
+func (i *Item) Matches(f Filter) bool {
+	switch {
+	case f.Type != "" && !strings.EqualFold(i.Type, f.Type):
+		return false
+	case f.Location != "" && !strings.EqualFold(i.Location, f.Location):
+		return false
+	case f.Within != nil && !f.Within[i.Location]:
+		return false
+	case f.LowStock && !i.LowStock():
+		return false
+	}
+
+	text := strings.ToLower(strings.Join([]string{
+		i.Name,
+		i.SKU,
+		i.Type,
+		i.Location,
+		strconv.FormatFloat(i.Size, 'f', -1, 64),
+		strings.Join(i.Barcodes, "\n"),
+	}, "\n"))
+	for _, word := range strings.Fields(strings.ToLower(f.Text)) {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LowStock reports whether the quantity of the item is at or below its
+// reorder point, or the `LowStockLevel` if it has none.
+func (i *Item) LowStock() bool {
+	if i.ReorderPoint > 0 {
+		return i.BelowReorderPoint()
+	}
+	return i.Quantity <= LowStockLevel
+}
+
+// Types returns the distinct types of the items sorted by name.
+func Types(items []*Item) []string {
+	return distinct(items, func(i *Item) string { return i.Type })
+}
+
+// Locations returns the distinct locations of the items sorted by name.
+func Locations(items []*Item) []string {
+	return distinct(items, func(i *Item) string { return i.Location })
+}
+
+func distinct(items []*Item, field func(*Item) string) []string {
+	seen := map[string]bool{}
+	values := []string{}
+	for _, i := range items {
+		v := field(i)
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		values = append(values, v)
+	}
+	sort.Strings(values)
+
+	return values
+}
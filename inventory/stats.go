@@ -0,0 +1,64 @@
+package inventory
+
+import "sort"
+
+// Stats are the aggregate numbers of the inventory shown on the dashboard.
+type Stats struct {
+	// Items is the number of items and Units the sum of their quantities.
+	Items int `json:"items"`
+	Units int `json:"units"`
+	// Value is the value of the stock (quantity × price) in each currency.
+	Value []Money `json:"value"`
+	// Types is the number of items of each type.
+	Types map[string]int `json:"types"`
+	// LowStock is the number of items low on stock.
+	LowStock int `json:"low_stock"`
+}
+
+// Summarize computes the stats of a list of items.
+func Summarize(items []*Item) *Stats {
+	s := &Stats{
+		Items: len(items),
+		Types: map[string]int{},
+	}
+
+	value := map[string]int64{}
+	for _, i := range items {
+		s.Units += i.Quantity
+		s.Types[i.Type]++
+		if i.LowStock() {
+			s.LowStock++
+		}
+		if v := i.Price.Times(i.Quantity); v.Amount != 0 {
+			value[v.Currency] += v.Amount
+		}
+	}
+
+	s.Value = []Money{}
+	for currency, amount := range value {
+		s.Value = append(s.Value, Money{Amount: amount, Currency: currency})
+	}
+	sort.Slice(s.Value, func(a, b int) bool {
+		return s.Value[a].Currency < s.Value[b].Currency
+	})
+
+	return s
+}
+
+// TypeCount is the number of items of a type.
+type TypeCount struct {
+	Type  string
+	Count int
+}
+
+// TypeCounts returns the number of items of each type, sorted by type.
+func (s *Stats) TypeCounts() []TypeCount {
+	counts := []TypeCount{}
+	for t, n := range s.Types {
+		counts = append(counts, TypeCount{Type: t, Count: n})
+	}
+	sort.Slice(counts, func(a, b int) bool {
+		return counts[a].Type < counts[b].Type
+	})
+	return counts
+}
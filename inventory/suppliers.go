@@ -0,0 +1,56 @@
+package inventory
+
+import "fmt"
+
+// SupplierLink links an item to a supplier it can be bought from.
+type SupplierLink struct {
+	// Supplier is the ID of the supplier.
+	Supplier string `yaml:"supplier" json:"supplier"`
+	// SKU is the code of the item in the catalogue of the supplier.
+	SKU string `yaml:"sku" json:"sku"`
+	// LastCost is the unit cost of the item at the last purchase from the
+	// supplier.
+	LastCost Money `yaml:"last_cost" json:"last_cost"`
+}
+
+// SupplierLink returns the link of the item to a supplier, if any.
+func (i *Item) SupplierLink(supplier string) (SupplierLink, bool) {
+	for _, l := range i.Suppliers {
+		if l.Supplier == supplier {
+			return l, true
+		}
+	}
+	return SupplierLink{}, false
+}
+
+// LinkSupplier adds or replaces the link of the item to a supplier and
+// updates the item in the store.
+func (i *Item) LinkSupplier(link SupplierLink) error {
+	if link.Supplier == "" {
+		return fmt.Errorf("inventory: a supplier link needs a supplier")
+	}
+
+	for n := range i.Suppliers {
+		if i.Suppliers[n].Supplier == link.Supplier {
+			i.Suppliers[n] = link
+			return i.Update()
+		}
+	}
+	i.Suppliers = append(i.Suppliers, link)
+
+	return i.Update()
+}
+
+// UnlinkSupplier removes the link of the item to a supplier and updates the
+// item in the store.
+func (i *Item) UnlinkSupplier(supplier string) error {
+	links := []SupplierLink{}
+	for _, l := range i.Suppliers {
+		if l.Supplier != supplier {
+			links = append(links, l)
+		}
+	}
+	i.Suppliers = links
+
+	return i.Update()
+}
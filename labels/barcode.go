@@ -0,0 +1,109 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/datamatrix"
+	"github.com/boombuler/barcode/ean"
+	"github.com/skip2/go-qrcode"
+)
+
+// Symbology is the kind of code printed on a label.
+type Symbology string
+
+const (
+	// QR is a QR code, which phones can scan to open a URL.
+	QR Symbology = "qr"
+	// DataMatrix is a compact 2D code, e.g. for small labels.
+	DataMatrix Symbology = "datamatrix"
+	// Code128 is a linear barcode for any text, e.g. the ID of a bin, read
+	// by handheld barcode scanners.
+	Code128 Symbology = "code128"
+	// EAN13 is the linear barcode of retail products, e.g. the barcode of
+	// the manufacturer of an item. UPC-A codes are printed as EAN-13.
+	EAN13 Symbology = "ean13"
+)
+
+// Symbologies lists the codes that can be printed on labels.
+var Symbologies = []Symbology{QR, DataMatrix, Code128, EAN13}
+
+// ParseSymbology parses the kind of code of a label. The empty string is a QR
+// code.
+func ParseSymbology(s string) (Symbology, error) {
+	if s == "" {
+		return QR, nil
+	}
+	for _, sym := range Symbologies {
+		if Symbology(s) == sym {
+			return sym, nil
+		}
+	}
+	return "", fmt.Errorf("labels: unknown barcode %q", s)
+}
+
+// Linear reports whether the code is a linear barcode, printed wide and short
+// instead of square.
+func (s Symbology) Linear() bool {
+	return s == Code128 || s == EAN13
+}
+
+// Encode returns the code with the given content as a PNG image. Square codes
+// are size pixels wide, and linear barcodes size pixels high with the width
+// of their bars.
+func Encode(s Symbology, content string, size int) ([]byte, error) {
+	if s == QR || s == "" {
+		qr, err := qrcode.Encode(content, qrcode.Medium, size)
+		if err != nil {
+			return nil, fmt.Errorf("labels: could not encode QR code: %w", err)
+		}
+		return qr, nil
+	}
+
+	var bc barcode.Barcode
+	var err error
+	switch s {
+	case DataMatrix:
+		bc, err = datamatrix.Encode(content)
+	case Code128:
+		bc, err = code128.Encode(content)
+	case EAN13:
+		if len(content) == 12 {
+			content = "0" + content
+		}
+		if len(content) != 13 {
+			return nil, fmt.Errorf("labels: %q is not an EAN-13 or UPC-A code", content)
+		}
+		bc, err = ean.Encode(content)
+	default:
+		return nil, fmt.Errorf("labels: unknown barcode %q", s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("labels: could not encode %s: %w", s, err)
+	}
+
+	// Scale by whole pixels so every bar or module keeps the same width.
+	width, height := size, size
+	if s.Linear() {
+		width = bc.Bounds().Dx() * 3
+	} else if n := bc.Bounds().Dx(); n > 0 {
+		width = (size / n) * n
+		if width == 0 {
+			width = n
+		}
+		height = width
+	}
+	bc, err = barcode.Scale(bc, width, height)
+	if err != nil {
+		return nil, fmt.Errorf("labels: could not scale %s: %w", s, err)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, bc); err != nil {
+		return nil, fmt.Errorf("labels: could not encode image: %w", err)
+	}
+	return buf.Bytes(), nil
+}
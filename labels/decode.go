@@ -0,0 +1,63 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+
+	"github.com/disintegration/imaging"
+	"github.com/edwvee/exiffix"
+	"github.com/makiuchi-d/gozxing"
+	"github.com/makiuchi-d/gozxing/datamatrix"
+	"github.com/makiuchi-d/gozxing/oned"
+	"github.com/makiuchi-d/gozxing/qrcode"
+)
+
+// ErrNoCode is returned when no code can be read in a picture.
+var ErrNoCode = errors.New("labels: no code found in the picture")
+
+// decodeSize is the largest side of the pictures decoded, as photos taken
+// by phones are much larger than needed to read a label.
+const decodeSize = 1600
+
+// quietZone is the white margin added around the pictures decoded.
+const quietZone = 40
+
+// Decode reads the code in a picture, e.g. a photo of a label taken with a
+// phone. QR codes are tried first, then DataMatrix, Code 128 and EAN-13 or
+// UPC-A barcodes.
+func Decode(r io.ReadSeeker) (string, error) {
+	img, _, err := exiffix.Decode(r)
+	if err != nil {
+		return "", fmt.Errorf("labels: could not decode image: %w", err)
+	}
+	img = imaging.Fit(img, decodeSize, decodeSize, imaging.Linear)
+
+	// Barcodes cannot be read without a quiet zone, so pictures cropped to
+	// the bars get a white margin.
+	b := img.Bounds()
+	img = imaging.PasteCenter(imaging.New(b.Dx()+2*quietZone, b.Dy()+2*quietZone, color.White), img)
+
+	bmp, err := gozxing.NewBinaryBitmapFromImage(img)
+	if err != nil {
+		return "", fmt.Errorf("labels: could not read image: %w", err)
+	}
+
+	hints := map[gozxing.DecodeHintType]interface{}{
+		gozxing.DecodeHintType_TRY_HARDER: true,
+	}
+	readers := []gozxing.Reader{
+		qrcode.NewQRCodeReader(),
+		datamatrix.NewDataMatrixReader(),
+		oned.NewCode128Reader(),
+		oned.NewMultiFormatUPCEANReader(hints),
+	}
+	for _, reader := range readers {
+		if res, err := reader.Decode(bmp, hints); err == nil {
+			return res.GetText(), nil
+		}
+	}
+
+	return "", ErrNoCode
+}
@@ -0,0 +1,116 @@
+package labels
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// Label is the content of a label: a code with a title and a few lines of
+// details, e.g. the name, SKU and ID of an item.
+type Label struct {
+	// Symbology is the kind of code printed on the label (default: QR).
+	Symbology Symbology
+	// Code is the content of the code, e.g. the URL of the item.
+	Code    string
+	Title   string
+	Details []string
+}
+
+// padding is the space kept empty around the content of each label, in
+// millimetres.
+const padding = 2.0
+
+// Render writes a PDF with the labels laid out on the sheet, filling as many
+// pages as needed. The first skip positions of the first page are left empty,
+// so a partly used sheet can be printed again.
+func Render(w io.Writer, s Sheet, labels []Label, skip int) error {
+	if skip < 0 || skip >= s.PerPage() {
+		skip = 0
+	}
+
+	pdf := fpdf.NewCustom(&fpdf.InitType{
+		UnitStr: "mm",
+		Size:    fpdf.SizeType{Wd: s.PageWidth, Ht: s.PageHeight},
+	})
+	pdf.SetAutoPageBreak(false, 0)
+	pdf.SetMargins(0, 0, 0)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for n, l := range labels {
+		pos := (n + skip) % s.PerPage()
+		if n == 0 || pos == 0 {
+			pdf.AddPage()
+		}
+		x, y := s.position(pos)
+
+		img, err := Encode(l.Symbology, l.Code, 256)
+		if err != nil {
+			return err
+		}
+		name := fmt.Sprintf("code%d", n)
+		pdf.RegisterImageOptionsReader(name, fpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(img))
+
+		// Linear barcodes take the width of the label with the text below
+		// them. Square codes take the left of wide labels and the top of tall
+		// ones, with the text next to them or below them.
+		inner, innerH := s.Width-2*padding, s.Height-2*padding
+		var cw, ch, tx, ty, tw float64
+		switch {
+		case l.Symbology.Linear():
+			cw, ch = inner, innerH/2
+			tx, ty, tw = x+padding, y+padding+ch+padding/2, inner
+		case s.Width >= s.Height:
+			cw = math.Min(innerH, inner/2)
+			ch = cw
+			tx, ty, tw = x+padding+cw+padding, y+padding, inner-cw-padding
+		default:
+			cw = math.Min(inner, innerH*2/3)
+			ch = cw
+			tx, ty, tw = x+padding, y+padding+ch+padding/2, inner
+		}
+		pdf.ImageOptions(name, x+padding, y+padding, cw, ch, false, fpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+		// Font sizes are in points; 1 pt is 0.3528 mm.
+		titleSize := math.Min(12, math.Max(5, s.Height/4))
+		if l.Symbology.Linear() {
+			titleSize = math.Min(10, math.Max(5, s.Height/6))
+		}
+		pdf.SetFont("Helvetica", "B", titleSize)
+		pdf.SetXY(tx, ty)
+		pdf.CellFormat(tw, titleSize*0.3528*1.3, fit(pdf, tr(l.Title), tw), "", 2, "L", false, 0, "")
+
+		pdf.SetFont("Helvetica", "", titleSize*0.75)
+		for _, d := range l.Details {
+			if d == "" {
+				continue
+			}
+			pdf.SetX(tx)
+			pdf.CellFormat(tw, titleSize*0.75*0.3528*1.3, fit(pdf, tr(d), tw), "", 2, "L", false, 0, "")
+		}
+	}
+	if len(labels) == 0 {
+		pdf.AddPage()
+	}
+
+	if err := pdf.Output(w); err != nil {
+		return fmt.Errorf("labels: could not write PDF: %w", err)
+	}
+	return nil
+}
+
+// fit shortens the text with an ellipsis until it fits in the width with the
+// current font. The text is already translated to the single byte encoding of
+// the font, so it can be cut at any byte.
+func fit(pdf *fpdf.Fpdf, s string, width float64) string {
+	if pdf.GetStringWidth(s) <= width {
+		return s
+	}
+	for len(s) > 0 && pdf.GetStringWidth(s+"...") > width {
+		s = s[:len(s)-1]
+	}
+	return s + "..."
+}
@@ -0,0 +1,100 @@
+package labels
+
+import (
+	"fmt"
+	"math"
+)
+
+// Page sizes in millimetres.
+const (
+	a4Width      = 210.0
+	a4Height     = 297.0
+	letterWidth  = 215.9
+	letterHeight = 279.4
+)
+
+// Sheet is the layout of a sheet of labels. Every size is in millimetres.
+type Sheet struct {
+	ID   string
+	Name string
+	// PageWidth and PageHeight are the size of the sheet.
+	PageWidth  float64
+	PageHeight float64
+	// Columns and Rows are the number of labels across and down the sheet.
+	Columns int
+	Rows    int
+	// Width and Height are the size of each label.
+	Width  float64
+	Height float64
+	// Left and Top are the margins before the first column and row.
+	Left float64
+	Top  float64
+	// GapX and GapY are the space between the columns and the rows.
+	GapX float64
+	GapY float64
+}
+
+// Sheets lists the common label sheets, e.g. Avery layouts.
+var Sheets = []Sheet{
+	{"l7160", "Avery L7160 (A4, 21 labels, 63.5 × 38.1 mm)", a4Width, a4Height, 3, 7, 63.5, 38.1, 7.2, 15.15, 2.5, 0},
+	{"l7163", "Avery L7163 (A4, 14 labels, 99.1 × 38.1 mm)", a4Width, a4Height, 2, 7, 99.1, 38.1, 4.65, 15.15, 2.5, 0},
+	{"l7165", "Avery L7165 (A4, 8 labels, 99.1 × 67.7 mm)", a4Width, a4Height, 2, 4, 99.1, 67.7, 4.65, 13.1, 2.5, 0},
+	{"l7651", "Avery L7651 (A4, 65 labels, 38.1 × 21.2 mm)", a4Width, a4Height, 5, 13, 38.1, 21.2, 4.7, 10.7, 2.5, 0},
+	{"5160", "Avery 5160 (Letter, 30 labels, 2.625 × 1 in)", letterWidth, letterHeight, 3, 10, 66.675, 25.4, 4.7625, 12.7, 3.175, 0},
+	{"5163", "Avery 5163 (Letter, 10 labels, 4 × 2 in)", letterWidth, letterHeight, 2, 5, 101.6, 50.8, 3.96875, 12.7, 4.7625, 0},
+}
+
+// Find returns the sheet with the given ID.
+func Find(id string) (Sheet, error) {
+	for _, s := range Sheets {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return Sheet{}, fmt.Errorf("labels: unknown label sheet %q", id)
+}
+
+// Custom returns a sheet of labels of the given size on an A4 or Letter page,
+// fitting as many labels as possible within margins of 10 mm and gaps of
+// 2 mm.
+func Custom(width, height float64, letter bool) (Sheet, error) {
+	s := Sheet{
+		ID:         "custom",
+		PageWidth:  a4Width,
+		PageHeight: a4Height,
+		Width:      width,
+		Height:     height,
+		GapX:       2,
+		GapY:       2,
+	}
+	if letter {
+		s.PageWidth, s.PageHeight = letterWidth, letterHeight
+	}
+
+	const margin = 10.0
+	s.Columns = int(math.Floor((s.PageWidth - 2*margin + s.GapX) / (width + s.GapX)))
+	s.Rows = int(math.Floor((s.PageHeight - 2*margin + s.GapY) / (height + s.GapY)))
+	if width <= 0 || height <= 0 || s.Columns < 1 || s.Rows < 1 {
+		return Sheet{}, fmt.Errorf("labels: labels of %g × %g mm do not fit on the page", width, height)
+	}
+
+	// Center the labels on the page.
+	s.Left = (s.PageWidth - float64(s.Columns)*width - float64(s.Columns-1)*s.GapX) / 2
+	s.Top = (s.PageHeight - float64(s.Rows)*height - float64(s.Rows-1)*s.GapY) / 2
+	s.Name = fmt.Sprintf("Custom (%d labels, %g × %g mm)", s.Columns*s.Rows, width, height)
+
+	return s, nil
+}
+
+// PerPage returns the number of labels on each sheet.
+func (s Sheet) PerPage() int {
+	return s.Columns * s.Rows
+}
+
+// position returns the top left corner of the nth label of a page.
+func (s Sheet) position(n int) (x, y float64) {
+	col, row := n%s.Columns, n/s.Columns
+	x = s.Left + float64(col)*(s.Width+s.GapX)
+	y = s.Top + float64(row)*(s.Height+s.GapY)
+	return x, y
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/medoix/warehouse/equipment"
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/locations"
+)
+
+// baseURL is the public URL of the warehouse passed with the `-url` flag, e.g.
+// https://warehouse.example.com. Links encoded in QR codes start with it, so
+// printed labels keep working when the warehouse is browsed at another
+// address. Without it, links use the address of the request.
+var baseURL *url.URL
+
+// parseBaseURL parses the public URL of the warehouse.
+func parseBaseURL(s string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSuffix(s, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%q is not an http or https URL", s)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return nil, fmt.Errorf("%q has a query or a fragment", s)
+	}
+	return u, nil
+}
+
+// link returns the absolute URL of a path of the warehouse.
+func link(r *http.Request, path string) string {
+	if baseURL != nil {
+		return baseURL.String() + path
+	}
+
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host + path
+}
+
+// Kinds of the short links encoded in QR labels.
+const (
+	linkItem      = "i"
+	linkEquipment = "e"
+	linkLocation  = "l"
+)
+
+// shortLink returns the permanent link of the QR label of an item, equipment
+// or location, e.g. https://warehouse.example.com/q/i/drill. It only holds
+// the ID, which does not change when the item is renamed, so the page it
+// opens can change without reprinting the labels.
+func shortLink(r *http.Request, kind, id string) string {
+	return link(r, "/q/"+kind+"/"+url.PathEscape(id))
+}
+
+// quickLink opens the page of a short link: /q/i/<id> for inventory items,
+// /q/e/<id> for equipment and /q/l/<id> for locations. /q/<code> opens any
+// code like the scanner page does.
+func quickLink(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/q/"), "/")
+
+	var target string
+	var err error
+	switch {
+	case len(parts) == 1:
+		target, err = scanTarget(parts[0])
+	case len(parts) == 2 && parts[0] == linkItem:
+		if _, err = inventory.Get(parts[1]); err == nil {
+			target = "/inventory/update?id=" + url.QueryEscape(parts[1])
+		}
+	case len(parts) == 2 && parts[0] == linkEquipment:
+		if _, err = equipment.Get(parts[1]); err == nil {
+			target = "/equipment/update?id=" + url.QueryEscape(parts[1])
+		}
+	case len(parts) == 2 && parts[0] == linkLocation:
+		if _, err = locations.Get(parts[1]); err == nil {
+			target = "/locations/scan?id=" + url.QueryEscape(parts[1])
+		}
+	default:
+		err = errors.New("unknown link")
+	}
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	http.Redirect(w, r, target, http.StatusSeeOther)
+}
@@ -0,0 +1,98 @@
+package locations
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	collection   = "locations"
+	locationYAML = "info.yaml"
+)
+
+// Kind is the level of a location in the tree of storage locations.
+type Kind string
+
+const (
+	// Site is a building or premises.
+	Site Kind = "site"
+	// Room is a room of a site.
+	Room Kind = "room"
+	// Rack is a rack, cabinet or shelving unit in a room.
+	Rack Kind = "rack"
+	// Shelf is a shelf of a rack.
+	Shelf Kind = "shelf"
+	// Bin is a bin or box on a shelf.
+	Bin Kind = "bin"
+)
+
+// Kinds lists the kinds of locations from the top to the bottom of the tree.
+var Kinds = []Kind{Site, Room, Rack, Shelf, Bin}
+
+// ParseKind parses the kind of a location, ignoring case.
+func ParseKind(s string) (Kind, error) {
+	for _, k := range Kinds {
+		if strings.EqualFold(s, string(k)) {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("locations: %q is not a kind of location", s)
+}
+
+// depth returns the level of the kind in the tree, starting at 0 for a site.
+func (k Kind) depth() int {
+	for n, kind := range Kinds {
+		if k == kind {
+			return n
+		}
+	}
+	return len(Kinds)
+}
+
+// Location is a place where items are stored. Locations form a tree, e.g. a
+// bin on a shelf of a rack in a room of a site.
+type Location struct {
+	ID   string `yaml:"id" json:"id"`
+	Name string `yaml:"name" json:"name"`
+	Kind Kind   `yaml:"kind" json:"kind"`
+	// Parent is the ID of the location containing this one, or empty for
+	// the top of the tree.
+	Parent  string    `yaml:"parent,omitempty" json:"parent,omitempty"`
+	Notes   string    `yaml:"notes" json:"notes"`
+	Created time.Time `yaml:"created" json:"created"`
+	Updated time.Time `yaml:"update" json:"updated"`
+}
+
+// Update updates the information of the location in the store.
+func (l *Location) Update() error {
+	l.Updated = time.Now()
+
+	data, err := yaml.Marshal(l)
+	if err != nil {
+		return fmt.Errorf("locations: could not marshal yaml file: %w", err)
+	}
+
+	if err := Store.Write(collection, l.ID, locationYAML, data); err != nil {
+		return fmt.Errorf("locations: could not write location: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the location from the store.
+func (l *Location) Delete() error {
+	err := Store.Delete(collection, l.ID)
+	if err != nil {
+		return fmt.Errorf("locations: could not delete location: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (l *Location) String() string {
+	return fmt.Sprintf("{%s (%s %s), Updated: %v}", l.ID, l.Kind, l.Name, l.Updated)
+}
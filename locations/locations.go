@@ -0,0 +1,190 @@
+package locations
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Store is the storage backend of the locations (default: the
+	// ~/.warehouse directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
+	// ErrNotFound is returned when a location does not exist.
+	ErrNotFound = errors.New("locations: location not found")
+	// ErrNoName is returned when adding or updating a location without a
+	// name.
+	ErrNoName = errors.New("locations: a location needs a name")
+	// ErrNotEmpty is returned when deleting a location that contains other
+	// locations.
+	ErrNotEmpty = errors.New("locations: the location contains other locations")
+)
+
+// Locations returns the list of locations, in the order of the tree.
+func Locations() ([]*Location, error) {
+	t, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return t.All(), nil
+}
+
+func locations() ([]*Location, error) {
+	files, err := Store.ReadAll(collection, locationYAML)
+	if err != nil {
+		return nil, fmt.Errorf("locations: could not read locations: %w", err)
+	}
+
+	locations := []*Location{}
+	for _, data := range files {
+		var l Location
+		if e := yaml.Unmarshal(data, &l); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("locations: could not parse location: %w", e))
+			continue
+		}
+		locations = append(locations, &l)
+	}
+
+	return locations, err
+}
+
+// Add adds a new location. It will auto-generate a unique ID for the location
+// based on the name.
+func Add(l *Location) (*Location, error) {
+	if err := l.check(nil); err != nil {
+		return nil, err
+	}
+	l.ID = uniqueKey(l.Name)
+	l.Created = time.Now()
+
+	if err := l.Update(); err != nil {
+		return nil, fmt.Errorf("locations: could not add location: %w", err)
+	}
+
+	return l, nil
+}
+
+// Get returns the location with the given ID.
+func Get(id string) (*Location, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
+	}
+
+	l := &Location{ID: id}
+	data, err := Store.Read(collection, id, locationYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("locations: could not read location: %w", err)
+	}
+	if err := yaml.Unmarshal(data, l); err != nil {
+		return nil, fmt.Errorf("locations: could not parse location: %w", err)
+	}
+
+	return l, nil
+}
+
+// Update replaces the information of the location with the same ID. The
+// creation date of the location is kept. A location cannot be moved inside
+// itself.
+func Update(l *Location) (*Location, error) {
+	old, err := Get(l.ID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	if err := l.check(t); err != nil {
+		return nil, err
+	}
+	l.Created = old.Created
+
+	if err := l.Update(); err != nil {
+		return nil, fmt.Errorf("locations: could not update location: %w", err)
+	}
+
+	return l, nil
+}
+
+// Delete deletes a location. Locations containing other locations cannot be
+// deleted.
+func Delete(id string) error {
+	t, err := Load()
+	if err != nil {
+		return err
+	}
+	l := t.Get(id)
+	if l == nil {
+		return ErrNotFound
+	}
+	if len(t.Children(id)) > 0 {
+		return ErrNotEmpty
+	}
+
+	if err := l.Delete(); err != nil {
+		return fmt.Errorf("locations: could not delete location: %w", err)
+	}
+	return nil
+}
+
+// check validates the fields of the location: its kind must be below the
+// kind of its parent, and with a tree of the existing locations, its parent
+// cannot be the location itself or one of its children.
+func (l *Location) check(t *Tree) error {
+	l.Name = strings.TrimSpace(l.Name)
+	if l.Name == "" {
+		return ErrNoName
+	}
+	if _, err := ParseKind(string(l.Kind)); err != nil {
+		return err
+	}
+	if l.Parent == "" {
+		return nil
+	}
+
+	parent, err := Get(l.Parent)
+	if err != nil {
+		return fmt.Errorf("locations: the parent location does not exist")
+	}
+	if l.Kind.depth() <= parent.Kind.depth() {
+		return fmt.Errorf("locations: a %s cannot be inside a %s", l.Kind, parent.Kind)
+	}
+	if t != nil && t.Within(l.ID)[l.Parent] {
+		return fmt.Errorf("locations: a location cannot be inside itself")
+	}
+	return nil
+}
+
+func uniqueKey(key string) string {
+	mark := 'a'
+	key = fmt.Sprintf("%.10s", clean(key))
+	if key == "" {
+		key = "location"
+	}
+	valid := key
+
+	for exists(valid) {
+		valid = fmt.Sprintf("%s_%s", key, string(mark))
+		mark++
+	}
+
+	return valid
+}
+
+func exists(key string) bool {
+	ok, err := Store.Exists(collection, key)
+	return ok || err != nil
+}
+
+var nonAlnum = regexp.MustCompile("[^[:alnum:]]+")
+
+func clean(s string) string {
+	return strings.ToLower(nonAlnum.ReplaceAllString(s, ""))
+}
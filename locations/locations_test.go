@@ -0,0 +1,113 @@
+package locations
+
+import (
+	"testing"
+
+	"github.com/medoix/warehouse/storage"
+)
+
+// setup stores a site with a room holding a rack, with the IDs "main",
+// "storeroom" and "rack1".
+func setup(t *testing.T) {
+	t.Helper()
+	Store = storage.NewDir(t.TempDir())
+
+	for _, l := range []*Location{
+		{Name: "Main", Kind: Site},
+		{Name: "Store room", Kind: Room, Parent: "main"},
+		{Name: "Rack 1", Kind: Rack, Parent: "storeroom"},
+	} {
+		if _, err := Add(l); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCheck(t *testing.T) {
+	setup(t)
+	tree, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		l     Location
+		tree  *Tree
+		valid bool
+	}{
+		{name: "site", l: Location{Name: "Annex", Kind: Site}, valid: true},
+		{name: "room in site", l: Location{Name: "Office", Kind: Room, Parent: "main"}, valid: true},
+		{name: "shelf in room", l: Location{Name: "Shelf", Kind: Shelf, Parent: "storeroom"}, valid: true},
+		{name: "bin in rack", l: Location{Name: "Bin", Kind: Bin, Parent: "rack1"}, valid: true},
+		{name: "no name", l: Location{Name: "  ", Kind: Site}},
+		{name: "unknown kind", l: Location{Name: "Drawer", Kind: "drawer"}},
+		{name: "missing parent", l: Location{Name: "Shelf", Kind: Shelf, Parent: "nowhere"}},
+		{name: "site in site", l: Location{Name: "Annex", Kind: Site, Parent: "main"}},
+		{name: "room in rack", l: Location{Name: "Office", Kind: Room, Parent: "rack1"}},
+		{name: "inside itself", l: Location{ID: "storeroom", Name: "Store room", Kind: Room, Parent: "storeroom"}, tree: tree},
+		{name: "inside its child", l: Location{ID: "main", Name: "Main", Kind: Site, Parent: "rack1"}, tree: tree},
+		{name: "moved", l: Location{ID: "rack1", Name: "Rack 1", Kind: Rack, Parent: "main"}, tree: tree, valid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.l.check(tt.tree)
+			if valid := err == nil; valid != tt.valid {
+				t.Errorf("check() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	setup(t)
+
+	texts := []string{"main", "Store room / Main", "rack 1", "Shelf A", "shelf a", "A-shelf", "", " Garage "}
+	ids, err := Migrate(texts, Bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"main":              "main",
+		"Store room / Main": "storeroom",
+		"rack 1":            "rack1",
+	}
+	for text, id := range want {
+		if ids[text] != id {
+			t.Errorf("%q is mapped to %q, want %q", text, ids[text], id)
+		}
+	}
+	if _, ok := ids[""]; ok {
+		t.Error("the empty text is mapped to a location")
+	}
+	if ids["Shelf A"] == "" || ids["shelf a"] != ids["Shelf A"] || ids["A-shelf"] != ids["Shelf A"] {
+		t.Errorf("the shelf texts are mapped to %q, %q and %q, want a single new location",
+			ids["Shelf A"], ids["shelf a"], ids["A-shelf"])
+	}
+
+	garage, err := Get(ids["Garage"])
+	if err != nil {
+		t.Fatalf("Garage is not a location: %v", err)
+	}
+	if garage.Name != "Garage" || garage.Kind != Bin || garage.Parent != "" {
+		t.Errorf("Garage is %+v, want a bin at the top of the tree", garage)
+	}
+
+	all, err := Locations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 5 {
+		t.Errorf("there are %d locations, want 5", len(all))
+	}
+
+	// Migrating again adds nothing.
+	if _, err := Migrate(texts, Bin); err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := Locations(); len(again) != len(all) {
+		t.Errorf("migrating again added %d locations", len(again)-len(all))
+	}
+}
@@ -0,0 +1,40 @@
+package locations
+
+import "strings"
+
+// Migrate maps free text locations, e.g. the locations of items from before
+// the tree of locations, to location records. Texts matching an existing
+// location with `Find` are mapped to it, and a location of the given kind is
+// added at the top of the tree for the others. It returns the ID of the
+// location of each text.
+func Migrate(texts []string, kind Kind) (map[string]string, error) {
+	t, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]string{}
+	for _, s := range texts {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if _, ok := ids[s]; ok {
+			continue
+		}
+		if l := t.Find(s); l != nil {
+			ids[s] = l.ID
+			continue
+		}
+
+		l, err := Add(&Location{Name: s, Kind: kind})
+		if err != nil {
+			return ids, err
+		}
+		t.byID[l.ID] = l
+		t.children[""] = append(t.children[""], l)
+		ids[s] = l.ID
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,159 @@
+package locations
+
+import (
+	"sort"
+	"strings"
+)
+
+// Separator separates the names of the locations in a path, e.g.
+// "Main / Store room / Rack 1".
+const Separator = " / "
+
+// Tree is the tree of locations loaded from the store.
+type Tree struct {
+	byID     map[string]*Location
+	children map[string][]*Location
+}
+
+// Load loads the tree of locations. Locations whose parent no longer exists
+// are shown at the top of the tree.
+func Load() (*Tree, error) {
+	all, err := locations()
+	if err != nil {
+		return nil, err
+	}
+
+	t := &Tree{
+		byID:     map[string]*Location{},
+		children: map[string][]*Location{},
+	}
+	for _, l := range all {
+		t.byID[l.ID] = l
+	}
+	for _, l := range all {
+		parent := l.Parent
+		if _, ok := t.byID[parent]; !ok {
+			parent = ""
+		}
+		t.children[parent] = append(t.children[parent], l)
+	}
+	for _, c := range t.children {
+		sort.Slice(c, func(a, b int) bool {
+			return strings.ToLower(c[a].Name) < strings.ToLower(c[b].Name)
+		})
+	}
+
+	return t, nil
+}
+
+// Get returns the location with the given ID, or nil if it does not exist.
+func (t *Tree) Get(id string) *Location {
+	return t.byID[id]
+}
+
+// Children returns the locations directly inside a location, sorted by name.
+// The empty ID returns the top of the tree.
+func (t *Tree) Children(id string) []*Location {
+	return t.children[id]
+}
+
+// All returns every location, each followed by the locations inside it.
+func (t *Tree) All() []*Location {
+	all := []*Location{}
+	var walk func(id string)
+	walk = func(id string) {
+		for _, l := range t.children[id] {
+			all = append(all, l)
+			walk(l.ID)
+		}
+	}
+	walk("")
+	return all
+}
+
+// Path returns the locations from the top of the tree down to the location.
+func (t *Tree) Path(id string) []*Location {
+	path := []*Location{}
+	seen := map[string]bool{}
+	for l := t.byID[id]; l != nil && !seen[l.ID]; l = t.byID[l.Parent] {
+		seen[l.ID] = true
+		path = append([]*Location{l}, path...)
+	}
+	return path
+}
+
+// Name returns the full name of the location, e.g. "Main / Store room /
+// Rack 1", or the ID itself if it is not a location.
+func (t *Tree) Name(id string) string {
+	path := t.Path(id)
+	if len(path) == 0 {
+		return id
+	}
+
+	names := make([]string, len(path))
+	for n, l := range path {
+		names[n] = l.Name
+	}
+	return strings.Join(names, Separator)
+}
+
+// Depth returns the number of locations above the location.
+func (t *Tree) Depth(id string) int {
+	if n := len(t.Path(id)); n > 0 {
+		return n - 1
+	}
+	return 0
+}
+
+// Within returns the IDs of the location and of every location inside it.
+func (t *Tree) Within(id string) map[string]bool {
+	within := map[string]bool{}
+	var walk func(id string)
+	walk = func(id string) {
+		if within[id] {
+			return
+		}
+		within[id] = true
+		for _, l := range t.children[id] {
+			walk(l.ID)
+		}
+	}
+	walk(id)
+	return within
+}
+
+// Find returns the location referred to by an ID, a full name or a name. Names
+// are compared with `Normalize`, and a name only matches if a single location
+// has it.
+func (t *Tree) Find(s string) *Location {
+	if l, ok := t.byID[s]; ok {
+		return l
+	}
+
+	key := Normalize(s)
+	if key == "" {
+		return nil
+	}
+	var found *Location
+	for id, l := range t.byID {
+		if Normalize(t.Name(id)) == key {
+			return l
+		}
+		if Normalize(l.Name) == key {
+			if found != nil {
+				return nil
+			}
+			found = l
+		}
+	}
+	return found
+}
+
+// Normalize returns a key for a free text location that ignores case,
+// punctuation and the order of the words, so "Shelf A", "shelf a" and
+// "A-shelf" are the same place.
+func Normalize(s string) string {
+	words := strings.Fields(strings.ToLower(nonAlnum.ReplaceAllString(s, " ")))
+	sort.Strings(words)
+	return strings.Join(words, " ")
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
@@ -9,35 +10,87 @@ import (
 	"image/jpeg"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"html/template"
+	"time"
 
+	"github.com/medoix/warehouse/audit"
+	"github.com/medoix/warehouse/customers"
 	"github.com/medoix/warehouse/inventory"
 	"github.com/medoix/warehouse/equipment"
+	"github.com/medoix/warehouse/labels"
+	"github.com/medoix/warehouse/locations"
+	"github.com/medoix/warehouse/notify"
+	"github.com/medoix/warehouse/orders"
+	"github.com/medoix/warehouse/purchases"
+	"github.com/medoix/warehouse/suppliers"
+	"github.com/medoix/warehouse/storage"
+	"github.com/medoix/warehouse/users"
 	"github.com/markbates/pkger"
-	"github.com/mitchellh/go-homedir"
 	"github.com/skip2/go-qrcode"
 )
 
 var templates *template.Template
 
 func main() {
+	if len(os.Args) > 1 && !strings.HasPrefix(os.Args[1], "-") {
+		runCommand(os.Args[1], os.Args[2:])
+		return
+	}
+
 	port := flag.Int("p", 8080, "port to serve the inventory")
 	path := flag.String("d", defaultPath(), "path to warehouse directory")
+	backend := flag.String("store", "dir", "storage backend: dir or sqlite")
+	db := flag.String("db", "", "path to the sqlite database (default: <dir>/warehouse.db)")
+	public := flag.String("url", "", "public URL of the warehouse encoded in QR codes, e.g. https://warehouse.example.com (default: the address it is browsed at)")
+	cert := flag.String("cert", "", "TLS certificate file to serve HTTPS")
+	key := flag.String("key", "", "TLS key file to serve HTTPS")
+	loanDays := flag.Int("loan", 14, "days equipment is lent for when no due date is given")
+	var alerts notifiers
+	flag.Var(&alerts, "notify", "send reorder alerts and overdue loan reminders to a notifier: log:/path, http(s)://webhook or smtp://host:port?from=..&to=.. (repeatable)")
 	flag.Parse()
 
 	if *path != defaultPath() {
 		if _, err := os.Stat(*path); os.IsNotExist(err) {
 			log.Fatalf("error with warehouse path: %v", err)
 		}
-		equipment.CustomPath = *path
-		inventory.CustomPath = *path
+	} else if err := os.MkdirAll(*path, os.ModePerm); err != nil {
+		log.Fatalf("error creating warehouse path: %v", err)
+	}
+
+	if *public != "" {
+		u, err := parseBaseURL(*public)
+		if err != nil {
+			log.Fatalf("error with public URL: %v", err)
+		}
+		baseURL = u
+	}
+	if *loanDays < 1 {
+		log.Fatalf("error with loan period: %d days", *loanDays)
+	}
+	if (*cert == "") != (*key == "") {
+		log.Fatalf("error with TLS: both -cert and -key are needed to serve HTTPS")
 	}
 
-	equipment.Items()
-	inventory.Items()
+	store, err := openStore(*backend, *path, *db)
+	if err != nil {
+		log.Fatalf("error opening warehouse store: %v", err)
+	}
+	defer store.Close()
+	equipment.Store = store
+	inventory.Store = store
+	users.Store = store
+	audit.Store = store
+	customers.Store = store
+	orders.Store = store
+	purchases.Store = store
+	suppliers.Store = store
+	locations.Store = store
 
 	f, err := os.OpenFile(filepath.Join(*path, "log"), os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
 	if err != nil {
@@ -46,45 +99,138 @@ func main() {
 	defer f.Close()
 	log.SetOutput(f)
 
+	var notifier notify.Notifier
+	if len(alerts) > 0 {
+		notifier = notify.Multi(alerts)
+	}
+	inventory.ReorderAlert = reorderAlert(notifier)
+	equipment.LoanPeriod = time.Duration(*loanDays) * 24 * time.Hour
+	go remindOverdue(notifier)
+
 	templates, err = initTemplates(pkger.Include("/templates"))
 	if err != nil {
 		log.Fatalf("error reading templates: %v", err)
 	}
 
+	// Login routes
+	http.HandleFunc("/login", login)
+	http.HandleFunc("/logout", logout)
+
 	// Dashbaord routes
-	http.HandleFunc("/", dashboardIndex)
+	http.HandleFunc("/", allow(users.Borrower, dashboardIndex))
 
 	// Equipment static content like images
-	http.Handle("/equipment/", http.StripPrefix("/equipment/", http.FileServer(http.Dir(inventory.Path()))))
+	http.Handle("/equipment/", allow(users.Staff, http.StripPrefix("/equipment/", storage.Handler(store, "equipment")).ServeHTTP))
 	// Equipment routes for actions
-	http.HandleFunc("/equipment/edit", equipmentEdit)
-	http.HandleFunc("/equipment/qr", equipmentQr)
-	http.HandleFunc("/equipment/location", equipmentLocation)
-	http.HandleFunc("/equipment/add", equipmentAdd)
-	http.HandleFunc("/equipment", equipmentIndex)
-
-	http.Handle("/inventory/", http.StripPrefix("/inventory/", http.FileServer(http.Dir(inventory.Path()))))
-	http.HandleFunc("/inventory/delete", inventoryDelete)
-	http.HandleFunc("/inventory/edit", inventoryEdit)
-	http.HandleFunc("/inventory/qr", inventoryQr)
-	http.HandleFunc("/inventory/location", inventoryLocation)
-	http.HandleFunc("/inventory/add", inventoryAdd)
-	http.HandleFunc("/inventory", inventoryIndex)
+	http.HandleFunc("/equipment/update", equipmentUpdate)
+	http.HandleFunc("/equipment/edit", allow(users.Staff, equipmentEdit))
+	http.HandleFunc("/equipment/qr", allow(users.Staff, equipmentQr))
+	http.HandleFunc("/equipment/location", allow(users.Borrower, equipmentLocation))
+	http.HandleFunc("/equipment/add", allow(users.Staff, equipmentAdd))
+	http.HandleFunc("/equipment/reserve", allow(users.Borrower, equipmentReserve))
+	http.HandleFunc("/equipment/calendar", allow(users.Borrower, equipmentCalendar))
+	http.HandleFunc("/equipment/plan", allow(users.Staff, equipmentPlan))
+	http.HandleFunc("/equipment/service", allow(users.Staff, equipmentService))
+	http.HandleFunc("/equipment/maintenance", allow(users.Staff, equipmentMaintenance))
+	http.HandleFunc("/equipment", allow(users.Borrower, equipmentIndex))
+
+	http.Handle("/inventory/", http.StripPrefix("/inventory/", allow(users.Staff, storage.Handler(store, "inventory").ServeHTTP)))
+	http.HandleFunc("/inventory/delete", allow(users.Admin, inventoryDelete))
+	http.HandleFunc("/inventory/edit", allow(users.Staff, inventoryEdit))
+	http.HandleFunc("/inventory/move", allow(users.Staff, inventoryMove))
+	http.HandleFunc("/inventory/supplier", allow(users.Staff, inventorySupplier))
+	http.HandleFunc("/inventory/update", allow(users.Staff, inventoryUpdate))
+	http.HandleFunc("/inventory/relocate", allow(users.Staff, inventoryRelocate))
+	http.HandleFunc("/inventory/labels", allow(users.Staff, inventoryLabels))
+	http.HandleFunc("/inventory/barcode", allow(users.Staff, inventoryBarcode))
+	http.HandleFunc("/inventory/qr", allow(users.Staff, inventoryQr))
+	http.HandleFunc("/inventory/location", allow(users.Staff, inventoryLocation))
+	http.HandleFunc("/inventory/add", allow(users.Staff, inventoryAdd))
+	http.HandleFunc("/inventory", allow(users.Staff, inventoryIndex))
+
+	// Customer routes
+	http.HandleFunc("/customers/add", allow(users.Staff, customerAdd))
+	http.HandleFunc("/customers/edit", allow(users.Staff, customerEdit))
+	http.HandleFunc("/customers/delete", allow(users.Admin, customerDelete))
+	http.HandleFunc("/customers", allow(users.Staff, customersIndex))
+
+	// Order routes
+	http.HandleFunc("/orders/add", allow(users.Staff, orderAdd))
+	http.HandleFunc("/orders/edit", allow(users.Staff, orderEdit))
+	http.HandleFunc("/orders/line", allow(users.Staff, orderLine))
+	http.HandleFunc("/orders/state", allow(users.Staff, orderState))
+	http.HandleFunc("/orders", allow(users.Staff, ordersIndex))
+
+	// Purchase order routes
+	http.HandleFunc("/purchases/add", allow(users.Staff, purchaseAdd))
+	http.HandleFunc("/purchases/edit", allow(users.Staff, purchaseEdit))
+	http.HandleFunc("/purchases/line", allow(users.Staff, purchaseLine))
+	http.HandleFunc("/purchases/state", allow(users.Staff, purchaseState))
+	http.HandleFunc("/purchases/receive", allow(users.Staff, purchaseReceive))
+	http.HandleFunc("/purchases", allow(users.Staff, purchasesIndex))
+
+	// Supplier routes
+	http.HandleFunc("/suppliers/add", allow(users.Staff, supplierAdd))
+	http.HandleFunc("/suppliers/edit", allow(users.Staff, supplierEdit))
+	http.HandleFunc("/suppliers/delete", allow(users.Admin, supplierDelete))
+	http.HandleFunc("/suppliers", allow(users.Staff, suppliersIndex))
+
+	// Location routes
+	http.HandleFunc("/locations/add", allow(users.Staff, locationAdd))
+	http.HandleFunc("/locations/edit", allow(users.Staff, locationEdit))
+	http.HandleFunc("/locations/delete", allow(users.Admin, locationDelete))
+	http.HandleFunc("/locations/qr", allow(users.Staff, locationQr))
+	http.HandleFunc("/locations/label", allow(users.Staff, locationLabel))
+	http.HandleFunc("/locations/scan", allow(users.Staff, locationScan))
+	http.HandleFunc("/locations", allow(users.Staff, locationsIndex))
+
+	// Scanner routes
+	http.HandleFunc("/scan", allow(users.Borrower, scan))
+	http.HandleFunc("/q/", allow(users.Borrower, quickLink))
+
+	// User management routes
+	http.HandleFunc("/users/add", allow(users.Admin, userAdd))
+	http.HandleFunc("/users/edit", allow(users.Admin, userEdit))
+	http.HandleFunc("/users/delete", allow(users.Admin, userDelete))
+	http.HandleFunc("/users", allow(users.Admin, usersIndex))
+
+	// Audit routes
+	http.HandleFunc("/audit", allow(users.Admin, auditIndex))
+
+	// JSON API routes
+	apiRoutes(http.DefaultServeMux)
 
 	fmt.Printf("warehouse server started on port %d\n", *port)
-	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", *port), nil))
+	if *cert != "" {
+		log.Fatal(http.ListenAndServeTLS(fmt.Sprintf(":%d", *port), *cert, *key, authenticate(http.DefaultServeMux)))
+	}
+	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", *port), authenticate(http.DefaultServeMux)))
 }
 
 func defaultPath() string {
-	home, err := homedir.Dir()
-	if err != nil {
-		panic(err)
+	return storage.DefaultPath()
+}
+
+// openStore opens the storage backend of the warehouse. The sqlite database
+// is kept inside the warehouse directory unless a path is given.
+func openStore(backend, path, db string) (storage.Store, error) {
+	switch backend {
+	case "dir":
+		return storage.NewDir(path), nil
+	case "sqlite":
+		if db == "" {
+			db = filepath.Join(path, "warehouse.db")
+		}
+		return storage.OpenSQLite(db)
 	}
-	return filepath.Join(home, ".warehouse")
+	return nil, fmt.Errorf("unknown storage backend %q", backend)
 }
 
 func initTemplates(dir string) (*template.Template, error) {
-	t := template.New("")
+	// "user" is replaced by the user of each request, see `render`.
+	t := template.New("").Funcs(template.FuncMap{
+		"user": func() *users.User { return nil },
+	})
 
 	err := pkger.Walk(dir, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -115,13 +261,150 @@ func initTemplates(dir string) (*template.Template, error) {
 	return t, err
 }
 
+// invalid reports a rejected form back to the user when the error is caused
+// by the values submitted, and logs it otherwise.
+func invalid(w http.ResponseWriter, err error) {
+	var verr *inventory.ValidationError
+	if errors.As(err, &verr) {
+		http.Error(w, verr.Error(), http.StatusBadRequest)
+		return
+	}
+	log.Println("[ERR]", err)
+}
+
+// inventoryFilter reads the search and filters of the inventory list from the
+// query of a request.
+func inventoryFilter(r *http.Request) inventory.Filter {
+	f := inventory.Filter{
+		Text:     r.FormValue("q"),
+		Type:     r.FormValue("type"),
+		LowStock: r.FormValue("low") != "",
+	}
+	f.Location, f.Within = locationFilter(r.FormValue("location"))
+	return f
+}
+
+// equipmentFilter reads the search and filters of the equipment list from the
+// query of a request. The in-use state is filtered with "yes" or "no",
+// "overdue" for the loans past their due date, or "service" for the items
+// that need maintenance.
+func equipmentFilter(r *http.Request) equipment.Filter {
+	f := equipment.Filter{
+		Text: r.FormValue("q"),
+	}
+	f.Location, f.Within = locationFilter(r.FormValue("location"))
+	switch r.FormValue("in_use") {
+	case "yes":
+		inUse := true
+		f.InUse = &inUse
+	case "no":
+		inUse := false
+		f.InUse = &inUse
+	case "overdue":
+		f.Overdue = true
+	case "service":
+		f.Service = true
+	}
+	return f
+}
+
 // Dashboard Functions
+
+// recentCount is the number of recently updated items on the dashboard.
+const recentCount = 10
+
+// dashboard holds the numbers shown on the dashboard, which are also served
+// as JSON for wall displays.
+type dashboard struct {
+	Inventory *inventory.Stats `json:"inventory"`
+	Equipment *equipment.Stats `json:"equipment"`
+	// Recent lists the recently updated inventory items and equipment.
+	Recent []*recentItem `json:"recent"`
+	// LowStock lists the inventory items low on stock.
+	LowStock []*inventory.Item `json:"low_stock"`
+	// OnOrder is the quantity of each item on open purchase orders.
+	OnOrder map[string]int `json:"on_order"`
+	Updated time.Time      `json:"updated"`
+}
+
+// recentItem is an inventory item or equipment updated recently.
+type recentItem struct {
+	Kind    string    `json:"kind"`
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Updated time.Time `json:"updated"`
+}
+
+// URL returns the page of the item.
+func (i *recentItem) URL() string {
+	return fmt.Sprintf("/%s/edit?id=%s", i.Kind, url.QueryEscape(i.ID))
+}
+
+func dashboardStats() (*dashboard, error) {
+	items, err := inventory.Items()
+	if err != nil {
+		return nil, err
+	}
+	equip, err := equipment.Items()
+	if err != nil {
+		return nil, err
+	}
+	equipStats, err := equipment.Summarize(equip)
+	if err != nil {
+		return nil, err
+	}
+	onOrder, err := purchases.OnOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &dashboard{
+		Inventory: inventory.Summarize(items),
+		Equipment: equipStats,
+		Recent:    []*recentItem{},
+		LowStock:  inventory.Filter{LowStock: true}.Apply(items),
+		OnOrder:   onOrder,
+		Updated:   time.Now(),
+	}
+	inventory.Sort(inventory.ByName, d.LowStock, false)
+
+	for _, i := range items {
+		d.Recent = append(d.Recent, &recentItem{"inventory", i.ID, i.Name, i.Updated})
+	}
+	for _, i := range equip {
+		d.Recent = append(d.Recent, &recentItem{"equipment", i.ID, i.Name, i.Updated})
+	}
+	sort.Slice(d.Recent, func(a, b int) bool {
+		return d.Recent[a].Updated.After(d.Recent[b].Updated)
+	})
+	if len(d.Recent) > recentCount {
+		d.Recent = d.Recent[:recentCount]
+	}
+
+	return d, nil
+}
+
 func dashboardIndex(w http.ResponseWriter, r *http.Request) {
-	if err := templates.ExecuteTemplate(w, "dashboard",
+	// Borrowers only deal with equipment.
+	if !currentUser(r).Can(users.Staff) {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	d, err := dashboardStats()
+	if err != nil {
+		log.Println("[ERR]", err)
+		http.Error(w, "could not compute the dashboard", 500)
+		return
+	}
+
+	if err := render(r).ExecuteTemplate(w, "dashboard",
 		&struct {
 			Title string
+			*dashboard
 		}{
-			Title: "Dashboard",
+			Title:     "Dashboard",
+			dashboard: d,
 		},
 	); err != nil {
 		log.Println("[ERR]", err)
@@ -131,12 +414,307 @@ func dashboardIndex(w http.ResponseWriter, r *http.Request) {
 
 // Equipment Functions
 func equipmentEdit(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		before := item
+		item, err = equipment.Update(id, r.FormValue("name"), r.FormValue("price"))
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if reason := strings.TrimSpace(r.FormValue("out_of_service")); reason != item.OutOfService {
+			if err := item.SetOutOfService(reason); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+		}
+		record(r, "equipment", item.ID, audit.Update, audit.Diff(before, item))
+
+		if r.FormValue("filename") != "" {
+			img, _, err := r.FormFile("image")
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			defer img.Close()
+			if err := item.SetPicture(img); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			record(r, "equipment", item.ID, audit.Picture, pictureChanged("picture"))
+		}
+
+		log.Println("[EDIT]", item)
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+
+	case "GET":
+		history, err := item.History()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		// Answer who had the item at the time asked for, if any.
+		var at time.Time
+		var holder string
+		if v := r.FormValue("at"); v != "" {
+			at, err = time.ParseInLocation("2006-01-02T15:04", v, time.Local)
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			holder, _, err = item.HolderAt(at)
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+		}
+
+		tree, err := locations.Load()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		maintenance, err := item.Maintenance()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		services, err := item.Services()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		plans := map[string]string{}
+		for _, p := range item.Plans {
+			plans[p.ID] = p.Name
+		}
+
+		if err := render(r).ExecuteTemplate(w, "equipment-edit",
+			&struct {
+				Title       string
+				Item        *equipment.Item
+				History     []*equipment.Event
+				At          time.Time
+				Holder      string
+				Tree        *locations.Tree
+				Maintenance []*equipment.ServiceDue
+				Services    []*equipment.Service
+				Plans       map[string]string
+			}{
+				Title:       item.Name,
+				Item:        item,
+				History:     history,
+				At:          at,
+				Holder:      holder,
+				Tree:        tree,
+				Maintenance: maintenance,
+				Services:    services,
+				Plans:       plans,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+// parseDue parses the due date of a loan, either a day like 2006-01-02, due
+// at the end of the day, or an exact RFC 3339 time. Loans without a due date
+// are due after the `equipment.LoanPeriod`.
+func parseDue(s string) (time.Time, error) {
+	if s == "" {
+		return time.Now().Add(equipment.LoanPeriod), nil
+	}
+	if d, err := time.ParseInLocation("2006-01-02", s, time.Local); err == nil {
+		return equipment.DueOn(d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid due date %q", s)
+	}
+	return t, nil
+}
+
+// equipmentUpdate is the page opened by scanning the QR code of an item. It
+// lets the user check out the item, or return it with a picture of the place
+// it was left at if the item is already in use.
+func equipmentUpdate(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		who := r.FormValue("who")
+		if who == "" {
+			http.Redirect(w, r, "/equipment/update?id="+item.ID, http.StatusSeeOther)
+			return
+		}
+
+		before, action := *item, audit.Checkout
+		due, err := parseDue(r.FormValue("due"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var location string
+		if item.InUse {
+			action = audit.Return
+			location, err = locationID(r.FormValue("location"))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			img, _, err := r.FormFile("image")
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			defer img.Close()
+			if err := item.SetLocationPicture(img); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+		}
+
+		if action == audit.Checkout {
+			err = item.Lend(who, due)
+		} else {
+			err = item.Return(location)
+		}
+		var conflict *equipment.ConflictError
+		if errors.As(err, &conflict) {
+			http.Error(w, conflict.Error()+", choose an earlier return date", http.StatusConflict)
+			return
+		} else if errors.Is(err, equipment.ErrOutOfService) || errors.Is(err, equipment.ErrServiceDue) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if errors.Is(err, equipment.ErrPastDue) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		} else if errors.Is(err, equipment.ErrLent) {
+			http.Error(w, err.Error(), http.StatusConflict)
+			return
+		} else if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		record(r, "equipment", item.ID, action, audit.Diff(&before, item))
+
+		log.Println("[USE]", item)
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+
+	case "GET":
+		page := "update"
+		if item.InUse {
+			page = "return"
+		}
+
+		// The item is due back by default the day before it is reserved
+		// next, but not before today.
+		due, upcoming := time.Now().Add(equipment.LoanPeriod), item.Upcoming()
+		if len(upcoming) > 0 && upcoming[0].Start.Before(due) {
+			due = upcoming[0].Start.AddDate(0, 0, -1)
+			if due.Before(time.Now()) {
+				due = time.Now()
+			}
+		}
+
+		// Items that need servicing first cannot be checked out.
+		var unusable string
+		if err := item.CheckService(); err != nil {
+			unusable = strings.TrimPrefix(err.Error(), "equipment: ")
+			if item.OutOfService != "" {
+				unusable += ": " + item.OutOfService
+			}
+		}
+
+		tree, err := locations.Load()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if err := render(r).ExecuteTemplate(w, page,
+			&struct {
+				Title        string
+				Item         *equipment.Item
+				Due          time.Time
+				Reservations []*equipment.Reservation
+				Unusable     string
+				Locations    []locationOption
+			}{
+				Title:        item.Name,
+				Item:         item,
+				Due:          due,
+				Reservations: upcoming,
+				Unusable:     unusable,
+				Locations:    locationOptions(tree, nil),
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
 }
 
 func equipmentQr(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	qr, err := qrcode.Encode(shortLink(r, linkEquipment, id), qrcode.Medium, 256)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	io.Copy(w, bytes.NewReader(qr))
 }
 
 func equipmentLocation(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	img, err := item.LocationPicture()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	jpeg.Encode(w, img, nil)
 }
 
 func equipmentAdd(w http.ResponseWriter, r *http.Request) {
@@ -148,6 +726,7 @@ func equipmentAdd(w http.ResponseWriter, r *http.Request) {
 			log.Println("[ERR]", err)
 			return
 		}
+		record(r, "equipment", item.ID, audit.Add, audit.Diff(nil, item))
 
 		img, _, err := r.FormFile("image")
 		if err != nil {
@@ -159,12 +738,13 @@ func equipmentAdd(w http.ResponseWriter, r *http.Request) {
 			log.Println("[ERR]", err)
 			return
 		}
+		record(r, "equipment", item.ID, audit.Picture, pictureChanged("picture"))
 
 		log.Println("[ADD]", item)
 		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
 
 	case "GET":
-		if err := templates.ExecuteTemplate(w, "equipment-add", nil); err != nil {
+		if err := render(r).ExecuteTemplate(w, "equipment-add", nil); err != nil {
 			log.Println("[ERR]", err)
 			return
 		}
@@ -177,14 +757,25 @@ func equipmentIndex(w http.ResponseWriter, r *http.Request) {
 		log.Println("[ERR]", err)
 		return
 	}
+	tree, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
 
-	if err := templates.ExecuteTemplate(w, "equipment",
+	if err := render(r).ExecuteTemplate(w, "equipment",
 		&struct {
-			Title string
-			Items []*equipment.Item
+			Title     string
+			Items     []*equipment.Item
+			Query     url.Values
+			Locations []locationOption
+			Tree      *locations.Tree
 		}{
-			Title: "Equipment",
-			Items: items,
+			Title:     "Equipment",
+			Items:     equipmentFilter(r).Apply(items),
+			Query:     r.URL.Query(),
+			Locations: locationOptions(tree, nil, equipment.Locations(items)...),
+			Tree:      tree,
 		},
 	); err != nil {
 		log.Println("[ERR]", err)
@@ -199,14 +790,34 @@ func inventoryIndex(w http.ResponseWriter, r *http.Request) {
 		log.Println("[ERR]", err)
 		return
 	}
+	onOrder, err := purchases.OnOrder()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	tree, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
 
-	if err := templates.ExecuteTemplate(w, "inventory",
+	if err := render(r).ExecuteTemplate(w, "inventory",
 		&struct {
-			Title string
-			Items []*inventory.Item
+			Title     string
+			Items     []*inventory.Item
+			Query     url.Values
+			Types     []string
+			Locations []locationOption
+			Tree      *locations.Tree
+			OnOrder   map[string]int
 		}{
-			Title: "Inventory",
-			Items: items,
+			Title:     "Inventory",
+			Items:     inventoryFilter(r).Apply(items),
+			Query:     r.URL.Query(),
+			Types:     inventory.Types(items),
+			Locations: locationOptions(tree, nil, inventory.Locations(items)...),
+			Tree:      tree,
+			OnOrder:   onOrder,
 		},
 	); err != nil {
 		log.Println("[ERR]", err)
@@ -225,12 +836,21 @@ func inventoryAdd(w http.ResponseWriter, r *http.Request) {
 		quantity := r.FormValue("quantity")
 		price    := r.FormValue("price")
 		location := r.FormValue("location")
-		item, err := inventory.Add(sku, name, itemtype, value, size, quantity, price, location)
+		reorderPoint    := r.FormValue("reorder_point")
+		reorderQuantity := r.FormValue("reorder_quantity")
+		barcodes        := r.FormValue("barcodes")
+		location, err := locationID(location)
 		if err != nil {
-			log.Println("[ERR]", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
-
+		item, err := inventory.Add(sku, name, itemtype, value, size, quantity, price, location, reorderPoint, reorderQuantity, barcodes)
+		if err != nil {
+			invalid(w, err)
+			return
+		}
+		record(r, "inventory", item.ID, audit.Add, audit.Diff(nil, item))
+
 		img, _, err := r.FormFile("image")
 		if err != nil {
 			log.Println("[ERR]", err)
@@ -241,12 +861,24 @@ func inventoryAdd(w http.ResponseWriter, r *http.Request) {
 			log.Println("[ERR]", err)
 			return
 		}
+		record(r, "inventory", item.ID, audit.Picture, pictureChanged("picture"))
 
 		log.Println("[ADD]", item)
 		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
 
 	case "GET":
-		if err := templates.ExecuteTemplate(w, "inventory-add", nil); err != nil {
+		tree, err := locations.Load()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err := render(r).ExecuteTemplate(w, "inventory-add",
+			&struct {
+				Locations []locationOption
+			}{
+				Locations: locationOptions(tree, nil),
+			},
+		); err != nil {
 			log.Println("[ERR]", err)
 			return
 		}
@@ -259,32 +891,111 @@ func inventoryQr(w http.ResponseWriter, r *http.Request) {
 		http.Redirect(w, r, "/", http.StatusSeeOther)
 		return
 	}
+	sym, err := labels.ParseSymbology(r.FormValue("symbology"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Square codes open the item when scanned with a phone, while linear
+	// barcodes hold the ID of the item, or its EAN-13 barcode, for handheld
+	// scanners.
+	content := shortLink(r, linkItem, id)
+	switch sym {
+	case labels.Code128:
+		content = id
+	case labels.EAN13:
+		item, err := inventory.Get(id)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		ean, ok := item.EAN13()
+		if !ok {
+			http.Error(w, "the item has no EAN-13 or UPC-A barcode", http.StatusBadRequest)
+			return
+		}
+		content = ean
+	}
 
-	qr, err := qrcode.Encode(fmt.Sprintf("http://%s/inventory/update?id=%s", r.Host, id), qrcode.Medium, 256)
+	img, err := labels.Encode(sym, content, 256)
 	if err != nil {
 		log.Println("[ERR]", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
 	w.Header().Set("Content-Type", "image/png")
-	io.Copy(w, bytes.NewReader(qr))
+	io.Copy(w, bytes.NewReader(img))
 }
 
 func inventoryDelete(w http.ResponseWriter, r *http.Request) {
 	id := r.FormValue("id")
-	if id == "" {
+	if id == "" || r.Method != "POST" {
 		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
 		return
 	}
 
-	err := inventory.Delete(id)
+	item, err := inventory.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	err = inventory.Delete(id)
 	if err != nil {
 		log.Println("[ERR]", err)
 		return
 	}
+	record(r, "inventory", id, audit.Delete, audit.Diff(item, nil))
+
+	log.Println("[DELETE]", id)
 	http.Redirect(w, r, "/inventory", http.StatusSeeOther)
 }
 
+// inventoryMove records a stock movement from the ledger form of the edit page.
+// Picks and write-offs are entered as positive amounts and stored as negative
+// movements.
+func inventoryMove(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
+		return
+	}
+
+	item, err := inventory.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	kind := inventory.Kind(r.FormValue("kind"))
+	quantity, err := strconv.Atoi(r.FormValue("quantity"))
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if (kind == inventory.Pick || kind == inventory.WriteOff) && quantity > 0 {
+		quantity = -quantity
+	}
+
+	location, err := locationID(r.FormValue("location"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	before := *item
+	m, err := item.Move(kind, quantity, r.FormValue("reason"), location)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	record(r, "inventory", item.ID, audit.Move, movementChanges(&before, item, m))
+
+	log.Println("[MOVE]", item.ID, m.Kind, m.Quantity)
+	http.Redirect(w, r, "/inventory/edit?id="+item.ID, http.StatusSeeOther)
+}
+
 func inventoryLocation(w http.ResponseWriter, r *http.Request) {
 	id := r.FormValue("id")
 	if id == "" {
@@ -322,6 +1033,9 @@ func inventoryEdit(w http.ResponseWriter, r *http.Request) {
 	price    := r.FormValue("price")
 	location := r.FormValue("location")
 	filename := r.FormValue("filename")
+	reorderPoint    := r.FormValue("reorder_point")
+	reorderQuantity := r.FormValue("reorder_quantity")
+	barcodes        := r.FormValue("barcodes")
 
 	if id == "" {
 		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
@@ -338,7 +1052,22 @@ func inventoryEdit(w http.ResponseWriter, r *http.Request) {
 		if item.ID == id {
 			switch r.Method {
 			case "POST":
-				inventory.Update(
+				// Stock moved since the page was loaded would be undone by
+				// saving the quantity shown on it.
+				if r.FormValue("loaded_quantity") != strconv.Itoa(item.Quantity) {
+					http.Error(w, "the quantity changed since the page was loaded, reload it and try again", http.StatusConflict)
+					return
+				}
+				// Items not migrated to the locations yet keep their
+				// free text location until it is changed.
+				if location != item.Location {
+					location, err = locationID(location)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+				}
+				updated, err := inventory.Update(
 					id,
 					sku,
 					name,
@@ -348,7 +1077,15 @@ func inventoryEdit(w http.ResponseWriter, r *http.Request) {
 					quantity,
 					price,
 					location,
+					reorderPoint,
+					reorderQuantity,
+					barcodes,
 				)
+				if err != nil {
+					invalid(w, err)
+					return
+				}
+				record(r, "inventory", id, audit.Update, audit.Diff(item, updated))
 
 				if(filename != ""){
 					img, _, err := r.FormFile("image")
@@ -361,17 +1098,60 @@ func inventoryEdit(w http.ResponseWriter, r *http.Request) {
 						log.Println("[ERR]", err)
 						return
 					}
+					record(r, "inventory", id, audit.Picture, pictureChanged("picture"))
 				}
 				http.Redirect(w, r, "/inventory", http.StatusSeeOther)
 
 			case "GET":
-				if err := templates.ExecuteTemplate(w, "inventory-edit",
+				ledger, err := item.Ledger()
+				if err != nil {
+					log.Println("[ERR]", err)
+					return
+				}
+				reserved, err := orders.Reserved()
+				if err != nil {
+					log.Println("[ERR]", err)
+					return
+				}
+				onOrder, err := purchases.OnOrder()
+				if err != nil {
+					log.Println("[ERR]", err)
+					return
+				}
+				supplierList, err := suppliers.Suppliers()
+				if err != nil {
+					log.Println("[ERR]", err)
+					return
+				}
+				tree, err := locations.Load()
+				if err != nil {
+					log.Println("[ERR]", err)
+					return
+				}
+
+				if err := render(r).ExecuteTemplate(w, "inventory-edit",
 					&struct {
-						Title string
-						Item  *inventory.Item
+						Title     string
+						Item      *inventory.Item
+						Ledger    []*inventory.Movement
+						Kinds     []inventory.Kind
+						Reserved  int
+						Available int
+						OnOrder   int
+						Suppliers []*suppliers.Supplier
+						Locations []locationOption
+						Tree      *locations.Tree
 					}{
-						Title: item.Name,
-						Item:  item,
+						Title:     item.Name,
+						Item:      item,
+						Ledger:    ledger,
+						Kinds:     inventory.Kinds,
+						Reserved:  reserved[item.ID],
+						Available: item.Quantity - reserved[item.ID],
+						OnOrder:   onOrder[item.ID],
+						Suppliers: supplierList,
+						Locations: locationOptions(tree, nil, item.Location),
+						Tree:      tree,
 					},
 				); err != nil {
 					log.Println("[ERR]", err)
@@ -381,3 +1161,1887 @@ func inventoryEdit(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// Customer Functions
+func customersIndex(w http.ResponseWriter, r *http.Request) {
+	all, err := customers.Customers()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	found := []*customers.Customer{}
+	for _, c := range all {
+		if c.Matches(r.FormValue("q"), r.FormValue("tag")) {
+			found = append(found, c)
+		}
+	}
+
+	if err := render(r).ExecuteTemplate(w, "customers",
+		&struct {
+			Title     string
+			Customers []*customers.Customer
+			Query     url.Values
+			Tags      []string
+		}{
+			Title:     "Customers",
+			Customers: found,
+			Query:     r.URL.Query(),
+			Tags:      customers.Tags(all),
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// customerForm reads a customer from the fields of the add and edit forms.
+func customerForm(r *http.Request) *customers.Customer {
+	c := &customers.Customer{
+		ID:      r.FormValue("id"),
+		Name:    strings.TrimSpace(r.FormValue("name")),
+		Company: r.FormValue("company"),
+		Email:   r.FormValue("email"),
+		Phone:   r.FormValue("phone"),
+		Notes:   r.FormValue("notes"),
+		Tags:    customers.ParseTags(r.FormValue("tags")),
+	}
+	for _, label := range customerAddresses {
+		a := customers.Address{
+			Label:    label,
+			Street:   r.FormValue(label + "_street"),
+			City:     r.FormValue(label + "_city"),
+			State:    r.FormValue(label + "_state"),
+			Postcode: r.FormValue(label + "_postcode"),
+			Country:  r.FormValue(label + "_country"),
+		}
+		if !a.IsZero() {
+			c.Addresses = append(c.Addresses, a)
+		}
+	}
+	return c
+}
+
+// customerAddresses are the labels of the addresses of the customer forms.
+var customerAddresses = []string{"billing", "shipping"}
+
+func customerAdd(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		c, err := customers.Add(customerForm(r))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "customer", c.ID, audit.Add, audit.Diff(nil, c))
+
+		log.Println("[ADD]", c)
+		http.Redirect(w, r, "/customers", http.StatusSeeOther)
+
+	case "GET":
+		if err := render(r).ExecuteTemplate(w, "customer-add",
+			&struct {
+				Title     string
+				Customer  *customers.Customer
+				Addresses []string
+			}{
+				Title:     "New Customer",
+				Customer:  &customers.Customer{},
+				Addresses: customerAddresses,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func customerEdit(w http.ResponseWriter, r *http.Request) {
+	c, err := customers.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/customers", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		before := c
+		c, err = customers.Update(customerForm(r))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "customer", c.ID, audit.Update, audit.Diff(before, c))
+
+		log.Println("[EDIT]", c)
+		http.Redirect(w, r, "/customers", http.StatusSeeOther)
+
+	case "GET":
+		if err := render(r).ExecuteTemplate(w, "customer-edit",
+			&struct {
+				Title     string
+				Customer  *customers.Customer
+				Addresses []string
+			}{
+				Title:     c.Name,
+				Customer:  c,
+				Addresses: customerAddresses,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func customerDelete(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/customers", http.StatusSeeOther)
+		return
+	}
+
+	c, err := customers.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if err := customers.Delete(id); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	record(r, "customer", id, audit.Delete, audit.Diff(c, nil))
+
+	log.Println("[DELETE]", id)
+	http.Redirect(w, r, "/customers", http.StatusSeeOther)
+}
+
+// Order Functions
+func ordersIndex(w http.ResponseWriter, r *http.Request) {
+	list, err := orders.Orders()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	names, err := customerNames()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	state := orders.State(r.FormValue("state"))
+	found := []*orders.Order{}
+	for _, o := range list {
+		if state == "" || o.State == state {
+			found = append(found, o)
+		}
+	}
+
+	if err := render(r).ExecuteTemplate(w, "orders",
+		&struct {
+			Title     string
+			Orders    []*orders.Order
+			Customers map[string]string
+			State     orders.State
+			States    []orders.State
+		}{
+			Title:     "Orders",
+			Orders:    found,
+			Customers: names,
+			State:     state,
+			States:    orders.States,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// customerNames returns the names of the customers by ID.
+func customerNames() (map[string]string, error) {
+	list, err := customers.Customers()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	for _, c := range list {
+		names[c.ID] = c.Name
+	}
+	return names, nil
+}
+
+func orderAdd(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		o, err := orders.Add(r.FormValue("customer"), r.FormValue("notes"))
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		record(r, "order", o.ID, audit.Add, audit.Diff(nil, o))
+
+		log.Println("[ADD]", o)
+		http.Redirect(w, r, "/orders/edit?id="+o.ID, http.StatusSeeOther)
+
+	case "GET":
+		list, err := customers.Customers()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if err := render(r).ExecuteTemplate(w, "order-add",
+			&struct {
+				Title     string
+				Customers []*customers.Customer
+			}{
+				Title:     "New Order",
+				Customers: list,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func orderEdit(w http.ResponseWriter, r *http.Request) {
+	o, err := orders.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/orders", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		before := *o
+		if err := o.Edit(r.FormValue("customer"), r.FormValue("notes")); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		record(r, "order", o.ID, audit.Update, audit.Diff(&before, o))
+
+		log.Println("[EDIT]", o)
+		http.Redirect(w, r, "/orders/edit?id="+o.ID, http.StatusSeeOther)
+
+	case "GET":
+		list, err := customers.Customers()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		items, err := inventory.SortedItems(inventory.ByName, false)
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		reserved, err := orders.Reserved()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if err := render(r).ExecuteTemplate(w, "order-edit",
+			&struct {
+				Title     string
+				Order     *orders.Order
+				Customers []*customers.Customer
+				Items     []*inventory.Item
+				Reserved  map[string]int
+			}{
+				Title:     o.ID,
+				Order:     o,
+				Customers: list,
+				Items:     items,
+				Reserved:  reserved,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+// orderLine adds or removes a line of a draft order.
+func orderLine(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/orders", http.StatusSeeOther)
+		return
+	}
+
+	o, err := orders.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	before := *o
+	before.Lines = append([]orders.Line{}, o.Lines...)
+	if r.FormValue("remove") != "" {
+		err = o.RemoveLine(r.FormValue("remove"))
+	} else {
+		var quantity int
+		quantity, err = strconv.Atoi(r.FormValue("quantity"))
+		if err == nil {
+			err = o.AddLine(r.FormValue("item"), quantity)
+		}
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "order", o.ID, audit.Update, audit.Diff(&before, o))
+
+	http.Redirect(w, r, "/orders/edit?id="+o.ID, http.StatusSeeOther)
+}
+
+// orderState moves an order to the next state, e.g. confirms or ships it.
+func orderState(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/orders", http.StatusSeeOther)
+		return
+	}
+
+	o, err := orders.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	before := *o
+	if err := o.Move(orders.State(r.FormValue("state"))); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "order", o.ID, audit.Update, audit.Diff(&before, o))
+	if o.State == orders.Shipped {
+		quantities := o.Quantities()
+		for id, q := range quantities {
+			quantities[id] = -q
+		}
+		recordMoves(r, inventory.Pick, quantities, "order "+o.ID+" shipped")
+	}
+
+	log.Println("[ORDER]", o)
+	http.Redirect(w, r, "/orders/edit?id="+o.ID, http.StatusSeeOther)
+}
+
+// Purchase Functions
+func purchasesIndex(w http.ResponseWriter, r *http.Request) {
+	list, err := purchases.Purchases()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	names, err := suppliers.Names()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	state := purchases.State(r.FormValue("state"))
+	found := []*purchases.Purchase{}
+	for _, p := range list {
+		if state == "" || p.State == state {
+			found = append(found, p)
+		}
+	}
+
+	if err := render(r).ExecuteTemplate(w, "purchases",
+		&struct {
+			Title     string
+			Purchases []*purchases.Purchase
+			Suppliers map[string]string
+			State     purchases.State
+			States    []purchases.State
+		}{
+			Title:     "Purchase Orders",
+			Purchases: found,
+			Suppliers: names,
+			State:     state,
+			States:    purchases.States,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+func purchaseAdd(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		var expected time.Time
+		if v := r.FormValue("expected"); v != "" {
+			var err error
+			expected, err = time.ParseInLocation("2006-01-02", v, time.Local)
+			if err != nil {
+				http.Error(w, "Invalid expected date.", http.StatusBadRequest)
+				return
+			}
+		}
+		p, err := purchases.Add(r.FormValue("supplier"), expected, r.FormValue("notes"))
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		record(r, "purchase", p.ID, audit.Add, audit.Diff(nil, p))
+
+		log.Println("[ADD]", p)
+		http.Redirect(w, r, "/purchases/edit?id="+p.ID, http.StatusSeeOther)
+
+	case "GET":
+		list, err := suppliers.Suppliers()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if err := render(r).ExecuteTemplate(w, "purchase-add",
+			&struct {
+				Title     string
+				Suppliers []*suppliers.Supplier
+			}{
+				Title:     "New Purchase Order",
+				Suppliers: list,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func purchaseEdit(w http.ResponseWriter, r *http.Request) {
+	p, err := purchases.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/purchases", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		before := *p
+		var expected time.Time
+		if v := r.FormValue("expected"); v != "" {
+			expected, err = time.ParseInLocation("2006-01-02", v, time.Local)
+			if err != nil {
+				http.Error(w, "Invalid expected date.", http.StatusBadRequest)
+				return
+			}
+		}
+		if err := p.Edit(r.FormValue("supplier"), expected, r.FormValue("notes")); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		record(r, "purchase", p.ID, audit.Update, audit.Diff(&before, p))
+
+		log.Println("[EDIT]", p)
+		http.Redirect(w, r, "/purchases/edit?id="+p.ID, http.StatusSeeOther)
+
+	case "GET":
+		items, err := inventory.SortedItems(inventory.ByName, false)
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		list, err := suppliers.Suppliers()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if err := render(r).ExecuteTemplate(w, "purchase-edit",
+			&struct {
+				Title     string
+				Purchase  *purchases.Purchase
+				Items     []*inventory.Item
+				Suppliers []*suppliers.Supplier
+			}{
+				Title:     p.ID,
+				Purchase:  p,
+				Items:     items,
+				Suppliers: list,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+// purchaseLine adds or removes a line of a draft purchase order.
+func purchaseLine(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/purchases", http.StatusSeeOther)
+		return
+	}
+
+	p, err := purchases.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	before := *p
+	before.Lines = append([]purchases.Line{}, p.Lines...)
+	if r.FormValue("remove") != "" {
+		err = p.RemoveLine(r.FormValue("remove"))
+	} else {
+		var quantity int
+		var cost inventory.Money
+		quantity, err = strconv.Atoi(r.FormValue("quantity"))
+		if err == nil {
+			cost, err = purchaseCost(p, r.FormValue("item"), r.FormValue("cost"))
+		}
+		if err == nil {
+			err = p.AddLine(r.FormValue("item"), quantity, cost)
+		}
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "purchase", p.ID, audit.Update, audit.Diff(&before, p))
+
+	http.Redirect(w, r, "/purchases/edit?id="+p.ID, http.StatusSeeOther)
+}
+
+// purchaseCost returns the unit cost of a line of a purchase order. Costs
+// entered without currency are in the currency of the supplier, and the last
+// cost of the item from the supplier is used if none is entered.
+func purchaseCost(p *purchases.Purchase, itemID, cost string) (inventory.Money, error) {
+	var currency string
+	if s, err := suppliers.Get(p.Supplier); err == nil {
+		currency = s.Currency
+	}
+	if strings.TrimSpace(cost) == "" {
+		if item, err := inventory.Get(itemID); err == nil {
+			if link, ok := item.SupplierLink(p.Supplier); ok {
+				return link.LastCost, nil
+			}
+		}
+	}
+	return inventory.ParseMoneyIn(cost, currency)
+}
+
+// purchaseState sends a purchase order to the supplier or cancels it.
+func purchaseState(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/purchases", http.StatusSeeOther)
+		return
+	}
+
+	p, err := purchases.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	before := *p
+	if err := p.Move(purchases.State(r.FormValue("state"))); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "purchase", p.ID, audit.Update, audit.Diff(&before, p))
+
+	log.Println("[PURCHASE]", p)
+	http.Redirect(w, r, "/purchases/edit?id="+p.ID, http.StatusSeeOther)
+}
+
+// purchaseReceive is the receiving screen of a purchase order. The quantity
+// delivered of each line is entered in its "receive_<item>" field.
+func purchaseReceive(w http.ResponseWriter, r *http.Request) {
+	p, err := purchases.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/purchases", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		quantities := map[string]int{}
+		for _, l := range p.Lines {
+			v := strings.TrimSpace(r.FormValue("receive_" + l.Item))
+			if v == "" {
+				continue
+			}
+			q, err := strconv.Atoi(v)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("Invalid quantity received of %s.", l.Name), http.StatusBadRequest)
+				return
+			}
+			quantities[l.Item] = q
+		}
+
+		before := *p
+		before.Lines = append([]purchases.Line{}, p.Lines...)
+		if err := p.Receive(quantities, r.FormValue("note")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "purchase", p.ID, audit.Update, audit.Diff(&before, p))
+		recordMoves(r, inventory.Receive, quantities, "purchase order "+p.ID)
+
+		log.Println("[RECEIVE]", p)
+		http.Redirect(w, r, "/purchases/edit?id="+p.ID, http.StatusSeeOther)
+
+	case "GET":
+		supplier := p.Supplier
+		if s, err := suppliers.Get(p.Supplier); err == nil {
+			supplier = s.Name
+		}
+
+		if err := render(r).ExecuteTemplate(w, "purchase-receive",
+			&struct {
+				Title    string
+				Purchase *purchases.Purchase
+				Supplier string
+			}{
+				Title:    "Receive " + p.ID,
+				Purchase: p,
+				Supplier: supplier,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+// Supplier Functions
+func suppliersIndex(w http.ResponseWriter, r *http.Request) {
+	list, err := suppliers.Suppliers()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	if err := render(r).ExecuteTemplate(w, "suppliers",
+		&struct {
+			Title     string
+			Suppliers []*suppliers.Supplier
+		}{
+			Title:     "Suppliers",
+			Suppliers: list,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// supplierForm reads a supplier from the fields of the add and edit forms.
+func supplierForm(r *http.Request) (*suppliers.Supplier, error) {
+	s := &suppliers.Supplier{
+		ID:       r.FormValue("id"),
+		Name:     strings.TrimSpace(r.FormValue("name")),
+		Contact:  r.FormValue("contact"),
+		Email:    r.FormValue("email"),
+		Phone:    r.FormValue("phone"),
+		Currency: r.FormValue("currency"),
+		Notes:    r.FormValue("notes"),
+	}
+	if v := strings.TrimSpace(r.FormValue("lead_time")); v != "" {
+		days, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("the lead time must be a number of days")
+		}
+		s.LeadTime = days
+	}
+	return s, nil
+}
+
+func supplierAdd(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		s, err := supplierForm(r)
+		if err == nil {
+			s, err = suppliers.Add(s)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "supplier", s.ID, audit.Add, audit.Diff(nil, s))
+
+		log.Println("[ADD]", s)
+		http.Redirect(w, r, "/suppliers", http.StatusSeeOther)
+
+	case "GET":
+		if err := render(r).ExecuteTemplate(w, "supplier-add",
+			&struct {
+				Title    string
+				Supplier *suppliers.Supplier
+			}{
+				Title:    "New Supplier",
+				Supplier: &suppliers.Supplier{Currency: inventory.DefaultCurrency},
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func supplierEdit(w http.ResponseWriter, r *http.Request) {
+	s, err := suppliers.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/suppliers", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		before := s
+		s, err = supplierForm(r)
+		if err == nil {
+			s, err = suppliers.Update(s)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "supplier", s.ID, audit.Update, audit.Diff(before, s))
+
+		log.Println("[EDIT]", s)
+		http.Redirect(w, r, "/suppliers", http.StatusSeeOther)
+
+	case "GET":
+		if err := render(r).ExecuteTemplate(w, "supplier-edit",
+			&struct {
+				Title    string
+				Supplier *suppliers.Supplier
+			}{
+				Title:    s.Name,
+				Supplier: s,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func supplierDelete(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/suppliers", http.StatusSeeOther)
+		return
+	}
+
+	s, err := suppliers.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if err := suppliers.Delete(id); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	record(r, "supplier", id, audit.Delete, audit.Diff(s, nil))
+
+	log.Println("[DELETE]", id)
+	http.Redirect(w, r, "/suppliers", http.StatusSeeOther)
+}
+
+// inventorySupplier links an item to a supplier, or unlinks it, from the
+// suppliers panel of the edit page.
+func inventorySupplier(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
+		return
+	}
+
+	item, err := inventory.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	before := *item
+	before.Suppliers = append([]inventory.SupplierLink{}, item.Suppliers...)
+	if supplier := r.FormValue("remove"); supplier != "" {
+		err = item.UnlinkSupplier(supplier)
+	} else {
+		var s *suppliers.Supplier
+		var cost inventory.Money
+		s, err = suppliers.Get(r.FormValue("supplier"))
+		if err == nil {
+			cost, err = inventory.ParseMoneyIn(r.FormValue("cost"), s.Currency)
+		}
+		if err == nil {
+			err = item.LinkSupplier(inventory.SupplierLink{
+				Supplier: s.ID,
+				SKU:      r.FormValue("sku"),
+				LastCost: cost,
+			})
+		}
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "inventory", item.ID, audit.Update, audit.Diff(&before, item))
+
+	http.Redirect(w, r, "/inventory/edit?id="+item.ID, http.StatusSeeOther)
+}
+
+// Location Functions
+
+// locationOption is a choice of location in the forms, indented by its depth
+// in the tree of locations.
+type locationOption struct {
+	ID    string
+	Label string
+}
+
+// locationOptions returns the choices of locations in the order of the tree,
+// skipping the locations in skip, followed by the free text locations of
+// items that are not in the tree yet.
+func locationOptions(t *locations.Tree, skip map[string]bool, extra ...string) []locationOption {
+	options := []locationOption{}
+	for _, l := range t.All() {
+		if skip[l.ID] {
+			continue
+		}
+		options = append(options, locationOption{
+			ID:    l.ID,
+			Label: strings.Repeat("   ", t.Depth(l.ID)) + l.Name,
+		})
+	}
+	for _, s := range extra {
+		if s != "" && t.Get(s) == nil {
+			options = append(options, locationOption{ID: s, Label: s})
+		}
+	}
+	return options
+}
+
+// locationID returns the ID of the location referred to by an ID or a name,
+// e.g. in the JSON API. Empty locations are allowed.
+func locationID(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", nil
+	}
+	t, err := locations.Load()
+	if err != nil {
+		return "", err
+	}
+	l := t.Find(s)
+	if l == nil {
+		return "", fmt.Errorf("%q is not a location", s)
+	}
+	return l.ID, nil
+}
+
+// locationFilter returns the IDs of a location and of the locations inside
+// it, to filter the items stored there. Free text locations not in the tree
+// are returned as is.
+func locationFilter(s string) (string, map[string]bool) {
+	if s == "" {
+		return "", nil
+	}
+	t, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return s, nil
+	}
+	if l := t.Find(s); l != nil {
+		return "", t.Within(l.ID)
+	}
+	return s, nil
+}
+
+// locationCount is the number of items stored at a location and the
+// locations inside it.
+type locationCount struct {
+	Inventory int
+	Equipment int
+}
+
+func locationsIndex(w http.ResponseWriter, r *http.Request) {
+	t, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	items, err := inventory.Items()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	equip, err := equipment.Items()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	// Items count for their location and every location above it.
+	counts := map[string]*locationCount{}
+	for _, l := range t.All() {
+		counts[l.ID] = &locationCount{}
+	}
+	for _, i := range items {
+		for _, l := range t.Path(i.Location) {
+			counts[l.ID].Inventory++
+		}
+	}
+	for _, i := range equip {
+		if i.InUse {
+			continue
+		}
+		for _, l := range t.Path(i.Location) {
+			counts[l.ID].Equipment++
+		}
+	}
+
+	if err := render(r).ExecuteTemplate(w, "locations",
+		&struct {
+			Title     string
+			Locations []*locations.Location
+			Tree      *locations.Tree
+			Counts    map[string]*locationCount
+		}{
+			Title:     "Locations",
+			Locations: t.All(),
+			Tree:      t,
+			Counts:    counts,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+func locationForm(r *http.Request) (*locations.Location, error) {
+	kind, err := locations.ParseKind(r.FormValue("kind"))
+	if err != nil {
+		return nil, err
+	}
+	return &locations.Location{
+		ID:     r.FormValue("id"),
+		Name:   r.FormValue("name"),
+		Kind:   kind,
+		Parent: r.FormValue("parent"),
+		Notes:  r.FormValue("notes"),
+	}, nil
+}
+
+func locationAdd(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "POST":
+		l, err := locationForm(r)
+		if err == nil {
+			l, err = locations.Add(l)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "location", l.ID, audit.Add, audit.Diff(nil, l))
+
+		log.Println("[ADD]", l)
+		http.Redirect(w, r, "/locations/edit?id="+url.QueryEscape(l.ID), http.StatusSeeOther)
+
+	case "GET":
+		t, err := locations.Load()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		// Adding from the page of a location adds a location inside it.
+		l := &locations.Location{Kind: locations.Site}
+		if parent := t.Get(r.FormValue("parent")); parent != nil {
+			l.Parent = parent.ID
+			for n, k := range locations.Kinds[:len(locations.Kinds)-1] {
+				if k == parent.Kind {
+					l.Kind = locations.Kinds[n+1]
+				}
+			}
+		}
+
+		if err := render(r).ExecuteTemplate(w, "location-add",
+			&struct {
+				Title    string
+				Location *locations.Location
+				Kinds    []locations.Kind
+				Parents  []locationOption
+			}{
+				Title:    "New Location",
+				Location: l,
+				Kinds:    locations.Kinds,
+				Parents:  locationOptions(t, nil),
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+// locationEdit shows everything stored at a location and the locations inside
+// it, and edits the location.
+func locationEdit(w http.ResponseWriter, r *http.Request) {
+	l, err := locations.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/locations", http.StatusSeeOther)
+		return
+	}
+
+	switch r.Method {
+	case "POST":
+		before := l
+		l, err = locationForm(r)
+		if err == nil {
+			l, err = locations.Update(l)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		record(r, "location", l.ID, audit.Update, audit.Diff(before, l))
+
+		log.Println("[EDIT]", l)
+		http.Redirect(w, r, "/locations/edit?id="+url.QueryEscape(l.ID), http.StatusSeeOther)
+
+	case "GET":
+		t, err := locations.Load()
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		within := t.Within(l.ID)
+		items, err := inventory.Search(inventory.Filter{Within: within})
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		inventory.Sort(inventory.ByName, items, false)
+		notInUse := false
+		equip, err := equipment.Search(equipment.Filter{Within: within, InUse: &notInUse})
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		equipment.Sort(equipment.ByName, equip, false)
+
+		if err := render(r).ExecuteTemplate(w, "location-edit",
+			&struct {
+				Title     string
+				Location  *locations.Location
+				Path      []*locations.Location
+				Children  []*locations.Location
+				Tree      *locations.Tree
+				Items     []*inventory.Item
+				Equipment []*equipment.Item
+				Kinds     []locations.Kind
+				Parents   []locationOption
+			}{
+				Title:     t.Name(l.ID),
+				Location:  l,
+				Path:      t.Path(l.ID),
+				Children:  t.Children(l.ID),
+				Tree:      t,
+				Items:     items,
+				Equipment: equip,
+				Kinds:     locations.Kinds,
+				Parents:   locationOptions(t, within),
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+func locationDelete(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/locations", http.StatusSeeOther)
+		return
+	}
+
+	l, err := locations.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	// Items cannot be left pointing at a location that no longer exists.
+	within := map[string]bool{id: true}
+	items, err := inventory.Search(inventory.Filter{Within: within})
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	equip, err := equipment.Search(equipment.Filter{Within: within})
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if len(items) > 0 || len(equip) > 0 {
+		http.Error(w, "the location still has items stored in it", http.StatusBadRequest)
+		return
+	}
+
+	if err := locations.Delete(id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "location", id, audit.Delete, audit.Diff(l, nil))
+
+	log.Println("[DELETE]", id)
+	http.Redirect(w, r, "/locations", http.StatusSeeOther)
+}
+
+// locationQr serves the QR code of a location, which opens the location when
+// scanned, or moves the item scanned just before into it.
+func locationQr(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" {
+		http.Redirect(w, r, "/locations", http.StatusSeeOther)
+		return
+	}
+	sym, err := labels.ParseSymbology(r.FormValue("symbology"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Linear barcodes hold the ID of the location for handheld scanners.
+	content := shortLink(r, linkLocation, id)
+	if sym.Linear() {
+		content = id
+	}
+
+	img, err := labels.Encode(sym, content, 256)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	io.Copy(w, bytes.NewReader(img))
+}
+
+// locationLabel shows a printable label of a location with its QR code.
+func locationLabel(w http.ResponseWriter, r *http.Request) {
+	t, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	l := t.Get(r.FormValue("id"))
+	if l == nil {
+		http.Redirect(w, r, "/locations", http.StatusSeeOther)
+		return
+	}
+
+	sym, err := labels.ParseSymbology(r.FormValue("symbology"))
+	if err != nil || sym == labels.EAN13 {
+		sym = labels.QR
+	}
+
+	if err := render(r).ExecuteTemplate(w, "location-label",
+		&struct {
+			Title     string
+			Location  *locations.Location
+			Path      string
+			Symbology string
+		}{
+			Title:     l.Name,
+			Location:  l,
+			Path:      t.Name(l.ID),
+			Symbology: string(sym),
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// inventoryLabels prints the QR labels of several items at once as a PDF on a
+// sheet of labels. The items are the ones picked on the page, or the ones
+// matching the search and filters of the inventory.
+func inventoryLabels(w http.ResponseWriter, r *http.Request) {
+	items, err := inventory.Items()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	inventory.Sort(inventory.ByName, items, false)
+	r.ParseForm()
+	if r.FormValue("pick") != "" {
+		picked := map[string]bool{}
+		for _, id := range r.Form["id"] {
+			picked[id] = true
+		}
+		found := []*inventory.Item{}
+		for _, i := range items {
+			if picked[i.ID] {
+				found = append(found, i)
+			}
+		}
+		items = found
+	} else {
+		items = inventoryFilter(r).Apply(items)
+	}
+
+	if r.FormValue("format") != "pdf" {
+		if err := render(r).ExecuteTemplate(w, "inventory-labels",
+			&struct {
+				Title       string
+				Items       []*inventory.Item
+				Query       url.Values
+				Sheets      []labels.Sheet
+				Symbologies []labels.Symbology
+			}{
+				Title:       "Labels",
+				Items:       items,
+				Query:       r.URL.Query(),
+				Sheets:      labels.Sheets,
+				Symbologies: labels.Symbologies,
+			},
+		); err != nil {
+			log.Println("[ERR]", err)
+		}
+		return
+	}
+
+	var sheet labels.Sheet
+	if r.FormValue("sheet") == "custom" {
+		width, werr := strconv.ParseFloat(r.FormValue("width"), 64)
+		height, herr := strconv.ParseFloat(r.FormValue("height"), 64)
+		if werr != nil || herr != nil {
+			http.Error(w, "Invalid label width or height.", http.StatusBadRequest)
+			return
+		}
+		sheet, err = labels.Custom(width, height, r.FormValue("paper") == "letter")
+	} else {
+		sheet, err = labels.Find(r.FormValue("sheet"))
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	skip := 0
+	if v := r.FormValue("skip"); v != "" {
+		skip, err = strconv.Atoi(v)
+		if err != nil || skip < 0 {
+			http.Error(w, "Invalid number of labels to skip.", http.StatusBadRequest)
+			return
+		}
+	}
+	sym, err := labels.ParseSymbology(r.FormValue("symbology"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	list := []labels.Label{}
+	for _, i := range items {
+		l := labels.Label{
+			Symbology: sym,
+			Code:      shortLink(r, linkItem, i.ID),
+			Title:     i.Name,
+		}
+		// Items without an EAN-13 barcode get a Code 128 barcode of their
+		// ID instead.
+		switch sym {
+		case labels.Code128:
+			l.Code = i.ID
+		case labels.EAN13:
+			if ean, ok := i.EAN13(); ok {
+				l.Code = ean
+			} else {
+				l.Symbology, l.Code = labels.Code128, i.ID
+			}
+		}
+		if i.SKU != "" {
+			l.Details = append(l.Details, "SKU "+i.SKU)
+		}
+		l.Details = append(l.Details, "ID "+i.ID)
+		list = append(list, l)
+	}
+
+	var buf bytes.Buffer
+	if err := labels.Render(&buf, sheet, list, skip); err != nil {
+		log.Println("[ERR]", err)
+		http.Error(w, "could not print the labels", 500)
+		return
+	}
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", `inline; filename="labels.pdf"`)
+	io.Copy(w, &buf)
+}
+
+// inventoryBarcode opens the item with the scanned barcode, either its ID or
+// one of its external barcodes, e.g. the EAN-13 barcode of the manufacturer.
+func inventoryBarcode(w http.ResponseWriter, r *http.Request) {
+	code := r.FormValue("code")
+	item, err := inventory.FindBarcode(code)
+	if errors.Is(err, inventory.ErrNotFound) {
+		http.Error(w, fmt.Sprintf("no item has the barcode %q", code), http.StatusNotFound)
+		return
+	} else if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	http.Redirect(w, r, "/inventory/update?id="+url.QueryEscape(item.ID), http.StatusSeeOther)
+}
+
+// Scan to move Functions
+
+// scanCookie remembers the item scanned last, so scanning the QR code of a
+// location next moves the item there.
+const scanCookie = "scan"
+
+// scanTimeout is how long a scanned item waits for a location to be scanned.
+const scanTimeout = 10 * time.Minute
+
+// inventoryUpdate is the page opened by scanning the QR code of an item. It
+// shows the item and waits for the QR code of a location to be scanned.
+func inventoryUpdate(w http.ResponseWriter, r *http.Request) {
+	item, err := inventory.Get(r.FormValue("id"))
+	if err != nil {
+		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
+		return
+	}
+	tree, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     scanCookie,
+		Value:    item.ID,
+		Path:     "/",
+		Expires:  time.Now().Add(scanTimeout),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	if err := render(r).ExecuteTemplate(w, "inventory-update",
+		&struct {
+			Title     string
+			Item      *inventory.Item
+			Tree      *locations.Tree
+			Locations []locationOption
+		}{
+			Title:     item.Name,
+			Item:      item,
+			Tree:      tree,
+			Locations: locationOptions(tree, map[string]bool{item.Location: true}),
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// locationScan is the page opened by scanning the QR code of a location. If an
+// item was scanned just before, it asks to move the item there, otherwise it
+// shows the location.
+func locationScan(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	t, err := locations.Load()
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	l := t.Get(id)
+	if l == nil {
+		http.Redirect(w, r, "/locations", http.StatusSeeOther)
+		return
+	}
+
+	var item *inventory.Item
+	if c, err := r.Cookie(scanCookie); err == nil {
+		item, _ = inventory.Get(c.Value)
+	}
+	if item == nil || item.Location == l.ID {
+		http.Redirect(w, r, "/locations/edit?id="+url.QueryEscape(l.ID), http.StatusSeeOther)
+		return
+	}
+
+	if err := render(r).ExecuteTemplate(w, "location-scan",
+		&struct {
+			Title    string
+			Item     *inventory.Item
+			Location *locations.Location
+			Tree     *locations.Tree
+		}{
+			Title:    "Move " + item.Name,
+			Item:     item,
+			Location: l,
+			Tree:     t,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// inventoryRelocate moves an item to another location, e.g. after scanning
+// the item and then a bin.
+func inventoryRelocate(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/inventory", http.StatusSeeOther)
+		return
+	}
+
+	item, err := inventory.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	location, err := locationID(r.FormValue("location"))
+	if err == nil && location == "" {
+		err = errors.New("choose the location to move the item to")
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	reason := "moved"
+	if item.Location != "" {
+		reason = "moved from " + item.Location
+	}
+
+	before := *item
+	m, err := item.Relocate(location, reason)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	record(r, "inventory", item.ID, audit.Move, movementChanges(&before, item, m))
+
+	// The scan is done, the next location scanned only opens the location.
+	http.SetCookie(w, &http.Cookie{Name: scanCookie, Path: "/", MaxAge: -1})
+
+	log.Println("[MOVE]", item.ID, m.Kind, m.Location)
+	http.Redirect(w, r, "/locations/edit?id="+url.QueryEscape(location), http.StatusSeeOther)
+}
+
+// Scanner Functions
+
+// scanPaths are the pages opened by the QR codes of labels printed before the
+// short links.
+var scanPaths = map[string]bool{
+	"/inventory/update": true,
+	"/equipment/update": true,
+	"/locations/scan":   true,
+	"/orders/edit":      true,
+}
+
+// scanTarget returns the page to open for a scanned code: the page of the URL
+// of a QR label, or the item, equipment, location or order with the code as
+// ID, or the item with the code as barcode.
+func scanTarget(code string) (string, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return "", errors.New("scan or type a code")
+	}
+
+	if u, err := url.Parse(code); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		if strings.HasPrefix(u.Path, "/q/") {
+			return u.Path, nil
+		}
+		if !scanPaths[u.Path] {
+			return "", fmt.Errorf("%q is not the code of a label", code)
+		}
+		return u.Path + "?" + u.RawQuery, nil
+	}
+
+	id := url.QueryEscape(code)
+	if item, err := inventory.FindBarcode(code); err == nil {
+		return "/inventory/update?id=" + url.QueryEscape(item.ID), nil
+	}
+	if _, err := equipment.Get(code); err == nil {
+		return "/equipment/update?id=" + id, nil
+	}
+	if _, err := locations.Get(code); err == nil {
+		return "/locations/scan?id=" + id, nil
+	}
+	if _, err := orders.Get(code); err == nil {
+		return "/orders/edit?id=" + id, nil
+	}
+
+	return "", fmt.Errorf("nothing has the code %q", code)
+}
+
+// scan is the scanner page. It reads the codes of labels and products with
+// the camera of the device, or from a photo or a handheld scanner, and opens
+// the page of the item, location or order scanned.
+func scan(w http.ResponseWriter, r *http.Request) {
+	code, message := r.FormValue("code"), ""
+	if img, _, err := r.FormFile("image"); err == nil {
+		defer img.Close()
+		code, err = labels.Decode(img)
+		if errors.Is(err, labels.ErrNoCode) {
+			message = "no code was found in the picture, take it again closer to the label"
+		} else if err != nil {
+			log.Println("[ERR]", err)
+			message = "could not read the picture"
+		}
+	}
+
+	if message == "" && (r.Method == "POST" || code != "") {
+		target, err := scanTarget(code)
+		if err == nil {
+			http.Redirect(w, r, target, http.StatusSeeOther)
+			return
+		}
+		message = err.Error()
+	}
+
+	if err := render(r).ExecuteTemplate(w, "scan",
+		&struct {
+			Title string
+			Code  string
+			Error string
+		}{
+			Title: "Scan",
+			Code:  code,
+			Error: message,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// Reservation Functions
+
+// calendarDays is the number of days shown on the equipment calendar.
+const calendarDays = 14
+
+// reservationTime is the format of the start and end of a reservation in the
+// web forms.
+const reservationTime = "2006-01-02T15:04"
+
+// calendarRow is the line of an item on the equipment calendar, with its
+// bookings on each day.
+type calendarRow struct {
+	Item *equipment.Item
+	Days [][]*equipment.Booking
+}
+
+// canCancel reports whether the user of a request can cancel a reservation:
+// staff, or the user who made it.
+func canCancel(r *http.Request, res *equipment.Reservation) bool {
+	u := currentUser(r)
+	return u.Can(users.Staff) || u != nil && res.By == u.Username
+}
+
+// equipmentCalendar shows the reservations and loans of the equipment, or of
+// one item, for two weeks starting on Monday of the current week.
+func equipmentCalendar(w http.ResponseWriter, r *http.Request) {
+	y, m, d := time.Now().Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+	start = start.AddDate(0, 0, -(int(start.Weekday())+6)%7)
+	if v := r.FormValue("start"); v != "" {
+		var err error
+		start, err = time.ParseInLocation("2006-01-02", v, time.Local)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid start date %q", v), http.StatusBadRequest)
+			return
+		}
+	}
+	end := start.AddDate(0, 0, calendarDays)
+
+	var item *equipment.Item
+	var items []*equipment.Item
+	var err error
+	if id := r.FormValue("id"); id != "" {
+		item, err = equipment.Get(id)
+		if err != nil {
+			http.Redirect(w, r, "/equipment/calendar", http.StatusSeeOther)
+			return
+		}
+		items = []*equipment.Item{item}
+	} else if items, err = equipment.SortedItems(equipment.ByName, false); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	days := make([]time.Time, calendarDays)
+	for n := range days {
+		days[n] = start.AddDate(0, 0, n)
+	}
+	rows := []*calendarRow{}
+	for _, i := range items {
+		bookings, err := i.Bookings(start, end)
+		if err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		row := &calendarRow{Item: i, Days: make([][]*equipment.Booking, calendarDays)}
+		for n, day := range days {
+			for _, b := range bookings {
+				if b.Start.Before(day.AddDate(0, 0, 1)) && day.Before(b.End) {
+					row.Days[n] = append(row.Days[n], b)
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	// Only the upcoming reservations the user can cancel get a button.
+	var upcoming []*equipment.Reservation
+	cancel := map[string]bool{}
+	if item != nil {
+		upcoming = item.Upcoming()
+		for _, res := range upcoming {
+			cancel[res.ID] = canCancel(r, res)
+		}
+	}
+
+	if err := render(r).ExecuteTemplate(w, "equipment-calendar",
+		&struct {
+			Title        string
+			Item         *equipment.Item
+			Days         []time.Time
+			Rows         []*calendarRow
+			Prev, Next   string
+			Reservations []*equipment.Reservation
+			Cancel       map[string]bool
+			Who          string
+		}{
+			Title:        "Calendar",
+			Item:         item,
+			Days:         days,
+			Rows:         rows,
+			Prev:         start.AddDate(0, 0, -calendarDays).Format("2006-01-02"),
+			Next:         end.Format("2006-01-02"),
+			Reservations: upcoming,
+			Cancel:       cancel,
+			Who:          actor(r),
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
+
+// equipmentReserve reserves an item for someone, or cancels a reservation
+// when "cancel" holds its ID.
+func equipmentReserve(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/equipment/calendar", http.StatusSeeOther)
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	back := "/equipment/calendar?id=" + url.QueryEscape(item.ID)
+	before := *item
+	before.Reservations = append([]*equipment.Reservation{}, item.Reservations...)
+
+	if c := r.FormValue("cancel"); c != "" {
+		res, err := item.Reservation(c)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if !canCancel(r, res) {
+			forbidden(w, r)
+			return
+		}
+		if err := item.Cancel(c); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		record(r, "equipment", item.ID, audit.Cancel, audit.Diff(&before, item))
+
+		log.Println("[CANCEL]", item.ID, res.Who, res.Start)
+		http.Redirect(w, r, back, http.StatusSeeOther)
+		return
+	}
+
+	start, err := time.ParseInLocation(reservationTime, r.FormValue("start"), time.Local)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid start %q", r.FormValue("start")), http.StatusBadRequest)
+		return
+	}
+	end, err := time.ParseInLocation(reservationTime, r.FormValue("end"), time.Local)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid end %q", r.FormValue("end")), http.StatusBadRequest)
+		return
+	}
+
+	res, err := item.Reserve(r.FormValue("who"), start, end, r.FormValue("notes"), actor(r))
+	var conflict *equipment.ConflictError
+	if errors.As(err, &conflict) {
+		http.Error(w, conflict.Error(), http.StatusConflict)
+		return
+	} else if errors.Is(err, equipment.ErrNoWho) || errors.Is(err, equipment.ErrBadPeriod) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	record(r, "equipment", item.ID, audit.Reserve, audit.Diff(&before, item))
+
+	log.Println("[RESERVE]", item.ID, res.Who, res.Start, res.End)
+	http.Redirect(w, r, back, http.StatusSeeOther)
+}
+
+// Maintenance Functions
+
+// equipmentPlan adds a maintenance plan to an item, or removes the plan whose
+// ID is in "remove".
+func equipmentPlan(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	before := *item
+	before.Plans = append([]*equipment.Plan{}, item.Plans...)
+
+	if remove := r.FormValue("remove"); remove != "" {
+		err = item.RemovePlan(remove)
+	} else {
+		// Either interval can be left empty.
+		days, checkouts := 0, 0
+		for field, n := range map[string]*int{"days": &days, "checkouts": &checkouts} {
+			v := strings.TrimSpace(r.FormValue(field))
+			if v == "" {
+				continue
+			}
+			if *n, err = strconv.Atoi(v); err != nil {
+				http.Error(w, "Invalid number of "+field+".", http.StatusBadRequest)
+				return
+			}
+		}
+		_, err = item.AddPlan(r.FormValue("name"), days, checkouts, r.FormValue("required") != "")
+	}
+	if errors.Is(err, equipment.ErrBadPlan) || errors.Is(err, equipment.ErrNoPlan) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	record(r, "equipment", item.ID, audit.Update, audit.Diff(&before, item))
+
+	http.Redirect(w, r, "/equipment/edit?id="+url.QueryEscape(item.ID), http.StatusSeeOther)
+}
+
+// equipmentService records the maintenance of an item with its attachments,
+// and puts the item back in service if asked to.
+func equipmentService(w http.ResponseWriter, r *http.Request) {
+	id := r.FormValue("id")
+	if id == "" || r.Method != "POST" {
+		http.Redirect(w, r, "/equipment", http.StatusSeeOther)
+		return
+	}
+
+	item, err := equipment.Get(id)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	attachments := map[string][]byte{}
+	if r.MultipartForm != nil {
+		for _, fh := range r.MultipartForm.File["attachments"] {
+			f, err := fh.Open()
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			data, err := io.ReadAll(f)
+			f.Close()
+			if err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			attachments[fh.Filename] = data
+		}
+	}
+
+	s, err := item.RecordService(r.FormValue("plan"), r.FormValue("notes"), actor(r), attachments)
+	if errors.Is(err, equipment.ErrNoPlan) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	} else if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	changes := []audit.Change{{Field: "service", After: s.Notes}}
+	if p, err := item.Plan(s.Plan); err == nil {
+		changes[0].After = p.Name
+	}
+	if r.FormValue("in_service") != "" && item.OutOfService != "" {
+		changes = append(changes, audit.Change{Field: "out_of_service", Before: item.OutOfService})
+		if err := item.SetOutOfService(""); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	record(r, "equipment", item.ID, audit.Service, changes)
+
+	log.Println("[SERVICE]", item.ID, s.Plan, s.Notes)
+	http.Redirect(w, r, "/equipment/edit?id="+url.QueryEscape(item.ID), http.StatusSeeOther)
+}
+
+// equipmentMaintenance lists the equipment out of service and the maintenance
+// plans that are due.
+func equipmentMaintenance(w http.ResponseWriter, r *http.Request) {
+	items, err := equipment.SortedItems(equipment.ByName, false)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	due, err := equipment.DueForService(items)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	out := []*equipment.Item{}
+	for _, i := range items {
+		if i.OutOfService != "" {
+			out = append(out, i)
+		}
+	}
+
+	if err := render(r).ExecuteTemplate(w, "equipment-maintenance",
+		&struct {
+			Title        string
+			Due          []*equipment.ServiceDue
+			OutOfService []*equipment.Item
+		}{
+			Title:        "Maintenance",
+			Due:          due,
+			OutOfService: out,
+		},
+	); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+}
@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSMTPHeaderInjection(t *testing.T) {
+	tests := []struct {
+		name    string
+		smtp    *SMTP
+		subject string
+	}{
+		{"subject", &SMTP{From: "a@b", To: []string{"c@d"}}, "Overdue: drill lent to kim\r\nBcc: e@f"},
+		{"from", &SMTP{From: "a@b\nBcc: e@f", To: []string{"c@d"}}, "Overdue"},
+		{"to", &SMTP{From: "a@b", To: []string{"c@d", "e@f\r\nX: y"}}, "Overdue"},
+	}
+
+	for _, tt := range tests {
+		// No server listens there, so only the rejected headers can fail
+		// before connecting.
+		tt.smtp.Addr = "127.0.0.1:1"
+		err := tt.smtp.Notify(tt.subject, "message")
+		if err == nil || !strings.Contains(err.Error(), "line break") {
+			t.Errorf("%s: Notify = %v, want the line break rejected", tt.name, err)
+		}
+	}
+}
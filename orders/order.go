@@ -0,0 +1,138 @@
+package orders
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/medoix/warehouse/inventory"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	collection = "orders"
+	orderYAML  = "info.yaml"
+)
+
+// State is the state of a sales order.
+type State string
+
+const (
+	// Draft orders can still be changed and reserve no stock.
+	Draft State = "draft"
+	// Confirmed orders reserve the stock of their lines.
+	Confirmed State = "confirmed"
+	// Picked orders have been put together and still reserve their stock.
+	Picked State = "picked"
+	// Shipped orders have left the warehouse and their stock is deducted.
+	Shipped State = "shipped"
+	// Cancelled orders release the stock they reserved.
+	Cancelled State = "cancelled"
+)
+
+// States lists the states of the orders in the order they go through.
+var States = []State{Draft, Confirmed, Picked, Shipped, Cancelled}
+
+// Reserves reports whether orders in the state reserve their stock.
+func (s State) Reserves() bool {
+	return s == Confirmed || s == Picked
+}
+
+// Line is a line of an order. The name and price of the item are kept as
+// they were when the line was added.
+type Line struct {
+	Item     string          `yaml:"item" json:"item"`
+	Name     string          `yaml:"name" json:"name"`
+	Quantity int             `yaml:"quantity" json:"quantity"`
+	Price    inventory.Money `yaml:"price" json:"price"`
+	// Deducted is set once the stock of the line is deducted on shipping, so
+	// shipping again after a failure does not deduct it twice.
+	Deducted bool `yaml:"deducted,omitempty" json:"deducted,omitempty"`
+}
+
+// Total returns the price of the line.
+func (l Line) Total() inventory.Money {
+	return l.Price.Times(l.Quantity)
+}
+
+// Order is a sales order of a customer.
+type Order struct {
+	ID       string    `yaml:"id" json:"id"`
+	Customer string    `yaml:"customer" json:"customer"`
+	State    State     `yaml:"state" json:"state"`
+	Lines    []Line    `yaml:"lines" json:"lines"`
+	Notes    string    `yaml:"notes" json:"notes"`
+	Created  time.Time `yaml:"created" json:"created"`
+	Updated  time.Time `yaml:"update" json:"updated"`
+}
+
+// Update updates the information of the order in the store.
+func (o *Order) Update() error {
+	o.Updated = time.Now()
+
+	data, err := yaml.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("orders: could not marshal yaml file: %w", err)
+	}
+
+	if err := Store.Write(collection, o.ID, orderYAML, data); err != nil {
+		return fmt.Errorf("orders: could not write order: %w", err)
+	}
+
+	return nil
+}
+
+// Totals returns the total price of the order in each currency of its lines.
+func (o *Order) Totals() []inventory.Money {
+	sums := map[string]int64{}
+	for _, l := range o.Lines {
+		sums[l.Price.Currency] += l.Total().Amount
+	}
+
+	totals := []inventory.Money{}
+	for currency, amount := range sums {
+		totals = append(totals, inventory.Money{Amount: amount, Currency: currency})
+	}
+	sort.Slice(totals, func(a, b int) bool {
+		return totals[a].Currency < totals[b].Currency
+	})
+
+	return totals
+}
+
+// Quantities returns the quantity ordered of each item.
+func (o *Order) Quantities() map[string]int {
+	q := map[string]int{}
+	for _, l := range o.Lines {
+		q[l.Item] += l.Quantity
+	}
+	return q
+}
+
+// Next returns the states the order can be moved to.
+func (o *Order) Next() []State {
+	switch o.State {
+	case Draft:
+		return []State{Confirmed, Cancelled}
+	case Confirmed:
+		return []State{Picked, Cancelled}
+	case Picked:
+		return []State{Shipped, Cancelled}
+	}
+	return nil
+}
+
+// CanMove reports whether the order can be moved to a state.
+func (o *Order) CanMove(to State) bool {
+	for _, s := range o.Next() {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (o *Order) String() string {
+	return fmt.Sprintf("{%s for %s, State: %s, Lines: %d}", o.ID, o.Customer, o.State, len(o.Lines))
+}
@@ -0,0 +1,328 @@
+package orders
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Store is the storage backend of the orders (default: the ~/.warehouse
+	// directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
+	// ErrNotFound is returned when an order does not exist.
+	ErrNotFound = errors.New("orders: order not found")
+	// ErrNotDraft is returned when changing the lines of an order that is
+	// no longer a draft.
+	ErrNotDraft = errors.New("orders: only draft orders can be changed")
+)
+
+// idPrefix is the prefix of the order numbers, e.g. "SO-00042".
+const idPrefix = "SO-"
+
+// mu serializes the changes of state of the orders, so two orders cannot
+// reserve the same stock at once.
+var mu sync.Mutex
+
+// StockError is returned when confirming an order for more stock than is
+// available.
+type StockError struct {
+	Item      string
+	Requested int
+	Available int
+}
+
+// Error implements the error interface.
+func (e *StockError) Error() string {
+	return fmt.Sprintf("orders: only %d of %s available, %d requested", e.Available, e.Item, e.Requested)
+}
+
+// Orders returns the list of orders sorted from the newest to the oldest.
+func Orders() ([]*Order, error) {
+	files, err := Store.ReadAll(collection, orderYAML)
+	if err != nil {
+		return nil, fmt.Errorf("orders: could not read orders: %w", err)
+	}
+
+	orders := []*Order{}
+	for _, data := range files {
+		var o Order
+		if e := yaml.Unmarshal(data, &o); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("orders: could not parse order: %w", e))
+			continue
+		}
+		orders = append(orders, &o)
+	}
+	sort.Slice(orders, func(a, b int) bool {
+		return orders[a].Created.After(orders[b].Created)
+	})
+
+	return orders, err
+}
+
+// Add adds a new draft order for a customer. Orders are numbered in
+// sequence.
+func Add(customer, notes string) (*Order, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	id, err := nextID()
+	if err != nil {
+		return nil, err
+	}
+	o := &Order{
+		ID:       id,
+		Customer: customer,
+		State:    Draft,
+		Lines:    []Line{},
+		Notes:    notes,
+		Created:  time.Now(),
+	}
+
+	if err := o.Update(); err != nil {
+		return nil, fmt.Errorf("orders: could not add order: %w", err)
+	}
+
+	return o, nil
+}
+
+// Get returns the order with the given ID.
+func Get(id string) (*Order, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
+	}
+
+	o := &Order{ID: id}
+	data, err := Store.Read(collection, id, orderYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("orders: could not read order: %w", err)
+	}
+	if err := yaml.Unmarshal(data, o); err != nil {
+		return nil, fmt.Errorf("orders: could not parse order: %w", err)
+	}
+
+	return o, nil
+}
+
+// Reserved returns the quantity of each inventory item reserved by the
+// confirmed and picked orders.
+func Reserved() (map[string]int, error) {
+	orders, err := Orders()
+	if err != nil {
+		return nil, err
+	}
+
+	reserved := map[string]int{}
+	for _, o := range orders {
+		if !o.State.Reserves() {
+			continue
+		}
+		for item, q := range o.Quantities() {
+			reserved[item] += q
+		}
+	}
+
+	return reserved, nil
+}
+
+// AddLine adds a quantity of an inventory item to a draft order at the
+// current price of the item. Adding an item already in the order increases
+// the quantity of its line.
+func (o *Order) AddLine(itemID string, quantity int) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := o.reload(); err != nil {
+		return err
+	}
+
+	if o.State != Draft {
+		return ErrNotDraft
+	}
+	if quantity <= 0 {
+		return fmt.Errorf("orders: the quantity must be positive")
+	}
+	item, err := inventory.Get(itemID)
+	if err != nil {
+		return err
+	}
+
+	for n := range o.Lines {
+		if o.Lines[n].Item == item.ID {
+			o.Lines[n].Quantity += quantity
+			return o.Update()
+		}
+	}
+	o.Lines = append(o.Lines, Line{
+		Item:     item.ID,
+		Name:     item.Name,
+		Quantity: quantity,
+		Price:    item.Price,
+	})
+
+	return o.Update()
+}
+
+// RemoveLine removes the line of an item from a draft order.
+func (o *Order) RemoveLine(itemID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := o.reload(); err != nil {
+		return err
+	}
+
+	if o.State != Draft {
+		return ErrNotDraft
+	}
+
+	lines := []Line{}
+	for _, l := range o.Lines {
+		if l.Item != itemID {
+			lines = append(lines, l)
+		}
+	}
+	o.Lines = lines
+
+	return o.Update()
+}
+
+// Edit changes the customer and notes of the order. The customer can only be
+// changed while the order is a draft.
+func (o *Order) Edit(customer, notes string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := o.reload(); err != nil {
+		return err
+	}
+
+	if o.State == Draft {
+		o.Customer = customer
+	}
+	o.Notes = notes
+
+	return o.Update()
+}
+
+// Move moves the order to another state. Confirming an order checks that
+// enough stock of every line is available, i.e. not reserved by other
+// orders, and reserves it. Shipping an order deducts its stock from the
+// inventory with a pick in the ledger of each item. Cancelling an order
+// releases the stock it reserved.
+func (o *Order) Move(to State) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := o.reload(); err != nil {
+		return err
+	}
+
+	if !o.CanMove(to) {
+		return fmt.Errorf("orders: a %s order cannot be %s", o.State, to)
+	}
+
+	switch to {
+	case Confirmed:
+		if len(o.Lines) == 0 {
+			return fmt.Errorf("orders: an order needs lines to be confirmed")
+		}
+		if err := o.checkStock(); err != nil {
+			return err
+		}
+	case Shipped:
+		if err := o.deduct(); err != nil {
+			return err
+		}
+	}
+
+	o.State = to
+	return o.Update()
+}
+
+// reload reads the order again from the store, so the checks made while
+// holding `mu` do not rely on a copy changed by another request since.
+func (o *Order) reload() error {
+	current, err := Get(o.ID)
+	if err != nil {
+		return err
+	}
+	*o = *current
+	return nil
+}
+
+func (o *Order) checkStock() error {
+	reserved, err := Reserved()
+	if err != nil {
+		return err
+	}
+
+	for id, q := range o.Quantities() {
+		item, err := inventory.Get(id)
+		if err != nil {
+			return fmt.Errorf("orders: could not check stock of %s: %w", id, err)
+		}
+		if available := item.Quantity - reserved[id]; q > available {
+			return &StockError{Item: item.Name, Requested: q, Available: available}
+		}
+	}
+
+	return nil
+}
+
+// deduct picks the stock of the lines not deducted yet, and saves each line
+// as deducted as soon as it is, so the lines already deducted are skipped when
+// shipping again after a failure.
+func (o *Order) deduct() error {
+	items := map[string]*inventory.Item{}
+	for _, l := range o.Lines {
+		if l.Deducted {
+			continue
+		}
+		item, err := inventory.Get(l.Item)
+		if err != nil {
+			return fmt.Errorf("orders: could not deduct stock of %s: %w", l.Item, err)
+		}
+		items[l.Item] = item
+	}
+
+	for n := range o.Lines {
+		l := &o.Lines[n]
+		if l.Deducted {
+			continue
+		}
+		if _, err := items[l.Item].Move(inventory.Pick, -l.Quantity, "order "+o.ID+" shipped", ""); err != nil {
+			return fmt.Errorf("orders: could not deduct stock of %s: %w", l.Item, err)
+		}
+		l.Deducted = true
+		if err := o.Update(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nextID returns the number of the next order.
+func nextID() (string, error) {
+	keys, err := Store.Keys(collection)
+	if err != nil {
+		return "", fmt.Errorf("orders: could not read orders: %w", err)
+	}
+
+	last := 0
+	for _, k := range keys {
+		n, err := strconv.Atoi(strings.TrimPrefix(k, idPrefix))
+		if err == nil && n > last {
+			last = n
+		}
+	}
+
+	return fmt.Sprintf("%s%05d", idPrefix, last+1), nil
+}
@@ -0,0 +1,235 @@
+package orders
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/storage"
+)
+
+// setup stores an inventory item "bolt" with the given quantity.
+func setup(t *testing.T, quantity int) {
+	t.Helper()
+	Store = storage.NewDir(t.TempDir())
+	inventory.Store = Store
+
+	item := &inventory.Item{ID: "bolt", Name: "Bolt", Quantity: quantity}
+	if err := item.Update(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func add(t *testing.T, quantity int) *Order {
+	t.Helper()
+	o, err := Add("acme", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.AddLine("bolt", quantity); err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+func stock(t *testing.T) int {
+	t.Helper()
+	item, err := inventory.Get("bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return item.Quantity
+}
+
+func TestCanMove(t *testing.T) {
+	tests := []struct {
+		from State
+		to   State
+		ok   bool
+	}{
+		{Draft, Confirmed, true},
+		{Draft, Cancelled, true},
+		{Draft, Picked, false},
+		{Draft, Shipped, false},
+		{Confirmed, Picked, true},
+		{Confirmed, Cancelled, true},
+		{Confirmed, Shipped, false},
+		{Confirmed, Draft, false},
+		{Picked, Shipped, true},
+		{Picked, Cancelled, true},
+		{Picked, Confirmed, false},
+		{Shipped, Cancelled, false},
+		{Shipped, Draft, false},
+		{Cancelled, Draft, false},
+		{Cancelled, Confirmed, false},
+	}
+
+	for _, tt := range tests {
+		o := &Order{State: tt.from}
+		if ok := o.CanMove(tt.to); ok != tt.ok {
+			t.Errorf("CanMove from %s to %s = %v, want %v", tt.from, tt.to, ok, tt.ok)
+		}
+	}
+}
+
+func TestMove(t *testing.T) {
+	setup(t, 10)
+
+	empty, err := Add("acme", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := empty.Move(Confirmed); err == nil {
+		t.Error("an order without lines was confirmed")
+	}
+
+	a, b := add(t, 6), add(t, 6)
+	if err := a.Move(Confirmed); err != nil {
+		t.Fatal(err)
+	}
+	var stockErr *StockError
+	if err := b.Move(Confirmed); !errors.As(err, &stockErr) || stockErr.Available != 4 {
+		t.Errorf("confirming more than the stock left = %v, want a StockError with 4 available", err)
+	}
+	if err := b.AddLine("bolt", 1); err != nil {
+		t.Errorf("AddLine on a draft = %v", err)
+	}
+
+	reserved, err := Reserved()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reserved["bolt"] != 6 {
+		t.Errorf("Reserved() = %v, want 6 bolts", reserved)
+	}
+	if err := a.AddLine("bolt", 1); err != ErrNotDraft {
+		t.Errorf("AddLine on a confirmed order = %v, want ErrNotDraft", err)
+	}
+
+	for _, s := range []State{Picked, Shipped} {
+		if err := a.Move(s); err != nil {
+			t.Fatalf("Move(%s) = %v", s, err)
+		}
+	}
+	if q := stock(t); q != 4 {
+		t.Errorf("the stock after shipping is %d, want 4", q)
+	}
+	if err := a.Move(Cancelled); err == nil {
+		t.Error("a shipped order was cancelled")
+	}
+
+	if err := b.Move(Cancelled); err != nil {
+		t.Fatal(err)
+	}
+	if reserved, _ := Reserved(); reserved["bolt"] != 0 {
+		t.Errorf("Reserved() = %v, want nothing reserved", reserved)
+	}
+}
+
+func TestMoveStaleCopy(t *testing.T) {
+	setup(t, 10)
+
+	o := add(t, 4)
+	for _, s := range []State{Confirmed, Picked} {
+		if err := o.Move(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Two requests load the order before either ships it.
+	first, err := Get(o.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Get(o.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Move(Shipped); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Move(Shipped); err == nil {
+		t.Error("the order was shipped twice")
+	}
+	if second.State != Shipped {
+		t.Errorf("the second copy is %s, want it reloaded as shipped", second.State)
+	}
+	if q := stock(t); q != 6 {
+		t.Errorf("the stock is %d, want 6", q)
+	}
+}
+
+func TestAddLineStaleCopy(t *testing.T) {
+	setup(t, 10)
+
+	o := add(t, 4)
+	stale, err := Get(o.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := o.Move(Confirmed); err != nil {
+		t.Fatal(err)
+	}
+	if err := stale.AddLine("bolt", 4); !errors.Is(err, ErrNotDraft) {
+		t.Errorf("AddLine on a copy of the confirmed order = %v, want ErrNotDraft", err)
+	}
+	current, err := Get(o.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if current.State != Confirmed || current.Lines[0].Quantity != 4 {
+		t.Errorf("the order is %s with %d bolts, want it confirmed with 4", current.State, current.Lines[0].Quantity)
+	}
+}
+
+// failingStore fails to append to the files of one record.
+type failingStore struct {
+	storage.Store
+	key string
+}
+
+func (s *failingStore) Append(collection, key, name string, data []byte) error {
+	if key == s.key {
+		return errors.New("disk full")
+	}
+	return s.Store.Append(collection, key, name, data)
+}
+
+func TestShipRetry(t *testing.T) {
+	setup(t, 10)
+	nut := &inventory.Item{ID: "nut", Name: "Nut", Quantity: 10}
+	if err := nut.Update(); err != nil {
+		t.Fatal(err)
+	}
+
+	o := add(t, 4)
+	if err := o.AddLine("nut", 2); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []State{Confirmed, Picked} {
+		if err := o.Move(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The ledger of the nuts cannot be written, after the bolts are picked.
+	inventory.Store = &failingStore{Store: Store, key: "nut"}
+	if err := o.Move(Shipped); err == nil {
+		t.Fatal("the order was shipped without deducting the nuts")
+	}
+	inventory.Store = Store
+	if err := o.Move(Shipped); err != nil {
+		t.Fatal(err)
+	}
+
+	if q := stock(t); q != 6 {
+		t.Errorf("the stock of bolts is %d, want 6", q)
+	}
+	item, err := inventory.Get("nut")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Quantity != 8 {
+		t.Errorf("the stock of nuts is %d, want 8", item.Quantity)
+	}
+}
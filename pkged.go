@@ -9,4 +9,4 @@ import (
 	"github.com/markbates/pkger/pkging/mem"
 )
 
-var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffec7d5973e248f2f857d9e0b5bd4607c2c811bf07034688010c02746d4c4ce842122a1da303101bf3ddff51a5030102e39e76ff77b7fd60a32aa5a43ab2f2aaccac7f376c6fed478de77f374c3bb612f551f3dda66be8bebd6fee94d0b0fc2432e0edbe1d369e1b4dcb778de27e10fa1b438ba30ae0438375033f8c674a6c359e6fbef3a131555ca3f1dc7015db6b3c34fabed6786e341e1a4b25348df8fc63a6df546defe479cef72fc06adb345162cd6a3cffabf1d8f8fda1b1881560349ee33031f202672891ef359e1b112cfd433702c3d30d4f4b9fff51ed81123aaa121b5133704c236c3c34187f600323826f869d7834fdc64303ded4e1e5efc5602000358d8da8f1d05803c5843f6edc7868dc7cfbf59bf0bfed994dd770cfe0ce06b969fc99d8816b78e71f3b87b3bdade1c57e989ec1d9b1661900584dd3ff271c66dd3e6b59e4d80101effe196abe0e07db8a5dd08c0d37004a0ccbb6ab9846731318b0dbb68ffe356d3f896dd07868001f567b46dcb4e238683c347c384881125bcdb50d0c78d178684471687b660487b46f04d978266bf4b2625c35df0d42238a9aebfcbb658579b0836a191c76d5e201d82a2a7bb1627b46d8047614e715c61e5d856910fbe54553c93e9815343bb0d074e565bd7a538f9463c1d04e8b3a4151387d51d1b4bdd8083d05340d7da7847a740e06801dc4b676acb15ca5522a1f0f154fcf47f9fc5694a831308e375c9d3a16e0739592d6aa14aa1d882c053f291154fba44ce144a57cf6c91854c6694f61f469a91938f6bef1d0303ccdd76dcfac5c3695c8c3ab6555898c76eba4c6f61484cb658d6554dfd6dcc0f55e290768251961e887b716a96e47700ccd50896ddf6b42d4ceda568131f4ddd6309ac6de5eaf51172af74c5f4dd66b05f84dcb088dbf4100de7dfc38d7ae1244f75093bb288ee1aa86fe9db4a91e2e8a75ffbc7d3f9d8a853b4d094164784dd387138766ef1d90d85eafdfa385d76f36553b8e8cf8364c68187ae403df45c86afa40f1cc473f349bfb6646555537a8bfa169761cd7df2ada5d7f27a78ea61f38e6a3ed3553c5058f5bb88e2d25b2f29fa6a20323248bdaa6166a592176c1350e507202cd077e785a6a060a30e20ab7d0436557164c3415d97589d5a89853b7530e830a01c2e7121a3209059c546941522daedd38f2c3b85ae519711c2a9a51adf3234423aa55810f40b57cfe4868ac81a1c5c08e4faa23db3381b106b6699d7c354a234d01a069ec0dcdf0b675b7120fd195b23e36a238e3a36555e245cadab00c454794a460bbeba896fdba1997853f4dd5368f9771545ce74cc1b55d23ff69ba0988ed404183862afe4cfcd8d083d0f6624545b4de43185ee1eec525fa578c6e5959343faf83ec37087dc47a613909412120f8111aa05c54a89118f2514757a6b10fca8b66947ab102c72f4cbc38eb4e7ed5d490005794cac15462df45fcf6e24e3e7017f5510a1b99235414879a8f66b210631e1a51ea69f9cff1f5f9fc361e1ab0e7d51574526e064a88c4dabcf58967e7e426bf6a26f11a6f9f963ba80891a2f1d0d81a9eee87cd131250883396a2590a81dd0715f820c5498c7a071afdc035782f5cc1e26f0127e1d62844a31b7096a3af6f435c4a453780dfe9314453dd8be09f6b445146f7ae01960bc14cd0427b172e08fd7dfa0e20d1b40245736e40d9baa75cb91da5514e18ebee222c8c0c2d0921fbd2ed30b93a5a19c2868a17adfdd0bd0554e0287ce13d701e7cdfef0f8da511c5a5fee525006455a5c695554d7c1d36f2f9df8dbb34d209544573cdf05e8597f127befe3e64d3f41f5d5f470ff04618d948e1c41ff176e3afbffe7a68acb39edcd4c39f4b1a8020a1ea0e7f7523566c80aabc4ca53e823d3422fb60349e49b2f5d07021997826f0d653abd3c25b38aaf9039191e7068111f83fb1f63f316249e0cf2de299683d92144d3fe1ed16f90dc79e314814ece80f283de56304e9dcf3bf1b7d63db7826e88706ebf98de7364153d813f9d09802db731acf389a06a3f18cb73b4f4f0f8d95ad379e710cc31e1accf152fce38f40d1b1c633f6d0e074f842eca1b138b6bd0b9cac272d8c6ec3a2af3911027a896d17b6626168f01b04d1265b78abf5d09846b0866c9324d6a29f3a7f3d3426ef8016ddfdeba1d1bb1f54fce38fc44b22436f3cff0b7bc01eb0dfd18c42b5f0cb70f26538f9329c7c194ebe0c275f86932fc3c997e1e4cb70f26538f9329c7c194ebe0c275f86932fc3c9df379ce4640c36d331efd59b2b5694bf1e1aba122b45af032584c252f9bae333e85bf799669aba1259aaaf84fa23e2eb372d3567b0a5b986ee14e69a1681d5db69f025413ee3f433de79a45a1d8ca43b1dfcc24eb3564074d5504312649b6a5d1a6a4812ef74bec750035b7dc550d3a933d43ce12da234a950c4138663244ed5196a288aa4a84e095af4b7ce50731df407186a9a55d3da89c9e6882b55904be3ccd1fe7234b064d897db57f2293b35b0548d2619f4d982cdd4fbe37afe5ae2f5abb15cf10d838c4c99e15d45a000db1b399238f52597b634776eb3439cee79f1133be4802a762349e420cc4e1274b060784beeb1e65ac47e9b31a3ad3c74be5d835d0a7ca22f0a58dc52849629117b4b232734eb4eb7aac759f0fb9acd7eeb99bec9f6f78e224a15189c1a2f27a6d6c30e2c33f2c602fa9ea9b934aeba1c907bdd83c20c1c95d4628dc0ec197c47efc59c315ca0dbdd8dca0c0eda01b325974e65864fe1f3fa90dbaa8b6eaa925a09cff65e3a32030896994692383dcc16a38d4aece2c992adc2c0f6f9930df55a19abce9830d3ea7bc60417e89bd66f19fc6e0bdf7bad5d720f7755866fc1b13386f35815794c16e6a6369cc79394fdd6b32fde595ef736bbad2a0c0275d3fa0dcea5cef0b1c6ec2d9d5999acd7b56482675482c658bb4bafe77e674ce47365c26b0b53855d366f0c9fc83d04f37f8d1fc5030cdd8eef20ff47b082f2e31d92be83f4e39d6748eac947fa09c36882c23b1f24fd780bef503f8ef467cdfe08ed27880e5ed2fe0e4575a83641d7d37e92c230b2002d3b5c4bfbaf827ed1fe5f90f61f97d755b20f6406e424227832d22e260bf84e650698bc78b13566e0c92b0b4842ce12367b5b25386a665e83c581eaad0ad8581240724a52290cd6c92222abbfd590ddd858600776f8124fd2ae2da738fa1e3b1c6d7581721069242c4b6668722c4e63169241bb967cc7323389556640a9248f8d053d55046e2d1253a0323b5315b8cd58d85b8640e37a0ff7341738224e6f64611f8d17f8416306c978f99a9ebcdfa553757124ddd93ba6900598123148e51efb5bc9267a5da0ba53cc10bb00b64517f69822762dcd9b6fb3f7e08e2472166b772d89e4029568d1ac4de31ac341f26db30c0e74c6daca7dccd618faa0f74a56506523077d08227909d9db60a3107aaa929094e31b95d86fb58d6f6aae6e4b0b733feebf4693c56e37debca6bf2d23532780a333667bb27cd98d9651a5ddd99fc2f0814c58d8dbe67537e9ad9e58860b3412b276a72dbb7b60f4237bb639656d397b3c63df687c629db17055b860a5812a68a6468e36b305bb55048a90050a53c9113526e3a7b1033059c092c58aa3d70b7aa708534c174760ec063b39654d49d86333f108b77407b12ce234dbebd48dd551144073856f75974f217eb005abee559f31f7e7b8a40fc14e25f45412bbbeb1e8d6d461b1024523661fa8eeea506d03fa638ab10098caacec9977d9f6598ff627cb93f69c8a13d7ebae8ebd44d089cef0c9390ed53f833b528a43bcddcae4c8d2188b6299cbbaf1225b979345ed3b03d5ebe27aaf5be0bfaf089c230b2d9b6580335b8c02283eaab0cf4bcc56106d912d75c883ea7c66f3feb2857462c6ec2dc9e5a38b3961f896dec35d45e023b9d7ada9c362b8fe550624b230cff0efb27d982c5a9006a6b238c0157104c5cad3752e66e299ec02a032908e80441bf2180bdfc70c52457885b4c686f4431638db5862b64e585bd6ee269280c36f900ad329be8fe8cb05ad72694c222c807053e422449f4b31f2fe67449c8e6461e02802e5b19b56674c4e7792d02a45498dec5aaa7d4ec7a8adce4c7d59981fc78ba7d7d9fbcd1331b9fe793952890106bf09e92a9a2bbb1b40fa85c66143c92ac9a7ec2b65a9c2eaa24d7fa78dfa706429025c731aa249e8d942542ede0b4565343fbb1a7cada1639006f468b83632b5e4f67ad948e208f28f9c9653877a9a32c291ea71d1afb21ecda9e4f198ced0c97881a792000e90e71d790dc4bdc14667c0563d6b6ff1570b6b63e57b8bf5ac307c2c2f5e626d380a5461901a0bd684f40cae498de46d5500587d3f4ee9e9491f00bdd65cb0d3983d40b85747a7e03b86685ecec7d1d6450e53096a2d12390e6d285e2356d6ac4723fc3f9fa38c16e6dfbe42238f2a94bcd55cac9ccf727eedcaf591a79dbd0ba9aea7b830ac57793997deeab97a5ce097e4d28eb16cfd06e9bbcee0512e3f01d59d23b81fa776151b9eff54f47bcc6f35f0852246b448fc3e1b1cd67a26e8c74eabd56eb5e8a7a70fdbe0481aff718a58d6eccf32c211ed365dea6c4587eb8d70d740bf14b15f5111bb5c67d735324de4038d819ad43c9604cef96fd7ceea39c685f615a86ec5107895d3d46b6d39578b24116c75711ecbe4289007f45a22f848ed6171265160fbc9c9fb1147a8e5e6b9b46e57a50559a036058754453ed219b093c41196739658660698b4809ac91453043a815a4d26517663a8d98c898183245f81c714868692f04e25a75845cbbc6a8c846d1a0bc8600af1c2aee3844a9ffd1e49de87d22292a696930b4e9d6b4b98227291bc60bf2d5d9e645f91849e193e377b5bbb574a10394a63684f73073b65e8983575716ee0dda331d9ec23491801f5a6343e376beae231c411a489f14e2ec5741481dae9e2bc9472324d81353597dfeb024821fe9e8f1fd40cc6821cc8c23ec351a439404dbf6b692eb00a6957657847f21c9a7591846bb30cfa2dcbbfa2e48f343c8433f0b7bb95ed2ea6302b732172405d9e48ea484a3a2d7fa8df98e60e02d4f694fd76222d56de3bebd1193ef52fd61bfcde6e06ba70fc0ef26267eacc2094175d8b1d760389e470cd5d992a21993a630196c934d4598fde9d498a179a82467294caac68d6cbf05312f6814cb4da12c143ad3c7d4b6b34e89375046239cdc6531b8e80ee02a017d68fc568a7b93ca1083cc9322086d2277b452b38ff3bb64b0fe421e7bf6dd8dd64d86dbda55d5f1680a70ce7edc9e665a70dcd2796a16dc5e53748ab16e76d969922ebc9dbeebe6f41ed422640f2e60e709de93cb14319681e1748c4201a0b834811f4e4cdc50399e1227919d9b7c6e3f8d73d682e5ca79ca533af6d45c02d99586d3586f2de8891a5112b62dadb07bc339aad3c6db7c0744fc506ddf96af0b6c2799fe3796fbe8265be8b7e9d697785ca93fde27540ce33d8fe84d90f56bcbc98f647f1925f85f2c1eacef969387756d4b4cfa72b07cf9ee7a73cefd08bc581ef0b2b3082b4bf86465735938ee4b1a71ac5e9bc67168621a48f908ec8818a2c83838d44f03bbd8fd9459f7fb321dd1fecf4219fca8b132b06c29b1cae42933e822bddadea4e7d49a03c48336586dee802924d1299e0314ed80355e013fd75442d84b93f22e1f7e5401635efb70505e7c89ccdeffa0ea90894a3925ac2f3a3c9d89da6b230c0e4558e7b037eb1ec5998c280c3188d057f100e5dfdb745ed185eb190d56b77759663c4f7a0fc90f372ad6e9df6f7b9c67a4d936d99a71a3e16433aa511bc93f3e233cbc1251f457ff5b0f1b9e500d22655589963b19bc239d73cc72ef1c79be2928b071fb0021eb571721468c32ee2ff55adf9ec1db5f4bab03a40de94f172f6db5ce09cefd0e8f375f1fa9f3bd68c95ca8244b3365d95e1ed9933b554770ad4fe6e2b5db1eefe602b457527e28a2e206f5532d71bca6fe71bc49bdd56197231e4934837112807c199fff70337880b8de823568a330b0541dee9258461cf2dec91e8e0044d3d61f4872d142856ec87592850b33fcd42d16a610568d9e12b168a2ba05f168a5fd942f101ebc4979b50ee26f476b13f6ba6330769953b241df5775ba55f81b9dc73a8773f4acfdf9b7387336bc1b966ab11bca5b9539fadba1a65fbcec8caa3b934d4d22047d8c882b555190e681bcc5ee5cf8ded56c2daa71aee6c315acac2203dd30ab3bec0775738db859470b937b9557bd8fe6c7fa1232dbabee6f2ee35cbc9f1f911a6da5d5b675aa7fb17ccc08652a42c9a346b637bd6eea62ab107b3c5a89012ec993370e4334e7cd6de0be9f1c36e5be7524d7f8f49c228922bfe03b0ad681fb8460282d28524cceb34c38e3ebca231f6f798d2eb1e24824638201174c46ea879b16f3eebd198d2af977a6643ce6787d38d4aa2fd50887bf6ccc9ad3c24e75fd36474c6343562bacd341438a7ecb785d03279710a6687dd5667cc8fb4b50bd7c9079f9948e2146307fc4e86d28d30bfda5ed8ff5a4b151c1748ab6ac66636e4728b6abd060369a3e60e12995899d9be357ea84854f7cf5ddebfba369cc15857a4ccad26b24d4598d30619e57be7683da4504b9b2d466bc9dd5baa1bd5e15bdd77ced62b88e51477d5211fc8751a44fd5f4711708f1d4e53e980d963a2420f7bf4939152236e88d363b2b4b0248ad7f520dd5187593f4a9f8e6166a5982d586c32ecb658bbb452d0ec66bed386a63d3ebc3376bdfab55ddfff0b49fd02676ecdd56cc83937c7f9c63cca0217e87d3a90fb783946ec867a32d29724b7a4d1ebe527b70fe1761cc8f64bb210285e23787a7d8f163f04407bc72a64903150875350ee8fdefceb26ea3def73b95bed8334e1567f3b3a534b6babed783208e0b23d6aa402fe0071f29e3500e9c3d8d96f256421a5931bb4a1f21d3eba7bbc6fe091cad01b4944daeb7d7401ca663d6aa212532bf319781fcfb2398f9fa086786b3eff363e0e51dbf8cc3f8e77c60e9483704befbdd893feeb76d267cdc972d59ef44f34da9af182fca57eaeaff1875c832ef7eacff0ea9c3fc03a4b72f760764b83472ed8b9b67e4526aefa11cc7a74f19dcff7212883a1eff421a8812f35f427acf5ae864e2c71f299209fc9ce63a7d3a29ec8a7ce079db951c2951f18c79335fb231a3ad62208bcd4a5499c68616d9caecdb882b508bc8cce293b5c9b71e52ae89786fe0b6ae835ebecaa961ea89e0c548fdb6a9ef35fef439073ba338974b4d55c0e68366eab24d4ec30a8ed93ec0dad0d6a0ce3e5b9f68a027ade64512bf7256f69eae75e81df19d003bfebaae42866193e91c8628f07c7d5211768cc20d57b34ba3f16d0fe16daf7415c7903256990e82e9fe80c9d1a0bda9299f9bd1ee3e7d6004f73695cbb94e83bb9b7a409e1668b11f2626537d47281af3ae3dc3befc263f27ccf2adbf3b42b739ff709597452a865967b52de34d417aca9317b4b22785f25f68e2cb2349b69a1e60a8bf9736b419d67f419aee5df439ef1bb0b6fc67ee95fe0aa247bdcab77722fd5cfe867e11f30ec469230050a434732c3c3311ee90c1fe71eb2971696773ded731f1472b4d5c5970b2defe8412d6fb5cd716f9e4716a3abdeb1577d26cebf8bbccc87a3ad5af1ec46cfdc98533ebb7f8aa735fb4b3f08770549d8e39fd6577710e9b7e6759079b8ff341c06d3c0703f6dadc277dfe86b76ff67e1f04ae42dd5e3027de87c527f07b82450982272549dd514f18d4597d38541a23300337e1e4ecf35176c3e0ba7b5e12890885bf3dc4d1561faf370baa249577d79ce9ebd16dd71cb125e44694447edf73add5a1e35eb4fc7718d019bccaf83fdb62aae6fce37eee5513647dfa0dca2c67aa56f8679f409b98eff175af2708a19c23e8b6611384ce9fbe9a4dfdd19fda8123156fa116d25370012897cf2b03738fea40cb4cd65b4d9dff60fca2d8cc8ff721904aa30f0e405bd535ded4972070779296163060873875ee887ee9823f46de6fb432d79c11af0828e7c78385437e8cdb10187ee1f06ec5cd4fb19ec64a7bef21c0f586ce2e2537e117b6faf83ee8a887bdcd2c1a6e268b1cc9eefae705e58e2a3f1743595172b8766edcec58ec075ff9e5bf4e8337d7f3ec3cfe76ff8f4bc4b3f4b7f8c629d1f7461142be2094db775a6654a1e9754fd34ca1da0dc37e56cbd562d6a553deaca7ba700d24e99413b50dfe6c42091083e9af568eb3429c0c9ce5d6d40ffac47237d0aea4d1f0ae8ff19fe1a47edf3ced0feba074abb5007a3eeb00b11f833463db7da8f14d16e75da9df6473d375a144e613fd02e849afd11bb1089d3add282f3d4a23b044652edfa4cbc38dd6a1f9d3cf20ed767e2bd06fa6517faa5ed427784fb9f1886d056536e62be42705859183805c129c2d8aec04e6581c20b589499e4d4f892e8cc20505d3ebd1a18223af124edeec6cb8929b95a8cbe875c30f8446678871d4206c56df5143fa84726712e5cda2a3982021b7c1e12f81819974e8d2f75ee08ad734156e9b3dfd0b60912f0a9372824ae45ecde8c2f570c575020da03a3d7cd830b705c477deb62e3e5e4d4d5244b39f03da904aaf37cb1a508e76eec80d7b588fdcf188e72059c662b61f52b2ce6611f7f96228ab6673f4919cbbf51dbcf729bfca71917d03c7dd27ca2678e290116a323cea2eff2f4fa7fce6854d7d7acee67e2ef8a00ed4f3326c0775f5ba7f0de4fc5dfa341e7930c09fb8a31a8becfa5d10afc4c7cfe4906a33a7cce8c453f951e1f0d359f64ccafb876d85d22a315985d91198eee14de4fc06faf74a5b267a0bcbed5f78f0597ddc2fd4b8342999ea84c37540694594011745faf18905462f4a72c4c51b0a5def74d89a00959649f2ed3e8e096e6ea81ba092c5de4b66f699790c511a608534bede196ca004fdd04b122708ecaac9eeada8502c7ce64a4ca9c5d714bebdcbd5614619e1b74f86cdd1de982ab087b70c1cb6dd65418e0c80c9f5cb8b15dd221f48e77e890251153a00de7a5c1679cfa360a6a1e66c18c591bcb792dda971bcfd84b231d418d503bfadf651ca2750224685317f08b658fda682e6fe90c3fcb8c841cbf7276bece58819652a87f1a1eedc48563b30c9dcc09cb525d1db6cf5189299eb910529e2c720395e1918bf0dc0323b9677ab20b225940eb137d5b16b9ae3ee4524518e13ab3f24764469b46e9cefcce3605f7ace332ddca6500d67b6eccdf56c4808034fa3fd74075cd39a01254b4f989694f4a15f82376a933931445dce5aad47e268967027b7cc23aed4ea7457f30dd0972556aff4093146af6474c523845b588d278f444911445d058ad490a8296114265876b4d525741bf4c52bfb249ea03d6a8af60a29bc14415979f775c94be8289720e5c09263a492ef9154c74bcf7b38289c074cc2f3f1cdc832cae1fff566e9dfa60e0129f49e31f7eaeb09a7cb06f4777918f3f5b6af11f0d962ab5d1cde7055a5d1ba7799116e9da7cf677db5a7c444efb577018ae855ccaacc38f2c49df08690f6c2fb3446869f76a70c56cc8a57f2f50e406dd2107e98c004e9ee434b3be0f39a8cd02a85589c42892042aac4bba58f79d331a1fa88216cbee1e5784daf450f5fdcd2c00d735e27793ef424d8ba7d78b6eee1282d953a8d1432d38770939066d5d68d0f56dba9662e2acff63e2f54630ddf5408f026fe43ef564a4d47281f328d0e57df8d60d9ec23b8a383f0fb4f9560d62431ad7e6ee76ada0b68f769bee085e82dfc9e8d7bd7dc9faae88c187be7162b5bcff3b19bdcac6e0fd40a0cd550df7bb829aaa0158d9eeddebb7bbdae1dd0a3a62b759e091741278f48303036feee2a1c0c0aabce97109cb4059063fe8c274238bd303bb697594455526754cc975628de1932c41abf3edcc12f0b136317c248b1cb8a06b75960881437418cadd33c6a9ca5f01cb8c82b1c8a59238f5ef6cd7759cbdc647f280bcd23de6d4e27ec147501d94b396356779547679bfd72af253dc7602c53406be7f8fc3ce29e8d12e724f16581c7bc65bcfc4d3e353ab8313ada776eb839e3a18f6f444ff48b3c8c792c05274a743b78fa1564f14493f75ea53ac60ed56fbe9e8d393f7b736c5ca55d02fabc82f6815395d60578d22f559a473a2a711d35411bb58e1f3aa0c394c1b4edae394dec84c2b51bca9230bfb40f7d84475796c4c503b7541db2a41631a09096cf765ba68edc69b5748c00e7a8ffe534babf75f12c9e31399d983b18b07aa4dfda9a5aca90814260b7a8ab64c17a383c2bc1edefaf35826b981e28d5ec7f814cc492e5ef0d370e1c66fbc30b01618be5dbece77e3941ea8affefe4d9cc62a19e08b8144189be948162c474ba3032fecedb7d52879e3bb91d1efb6a6503927475b8d9c6e3517788ad0a2597790a80445a9028d6b798e4709e5259b7fde214670c28686724f10ec2968413ca9f6fb09aa909b638b78265a8fe5a1fa1f249e549b6cfdc0b38c50ab3fe4e5d8c25b657e2ab24d92588b7eead47b399e8016fdadf772bc06fa453c7f51e2795c60b789e789b5b837785d62d395c077072c939f7ab6d9a3fd2896815a8246b32e92860b7f3d9410f96ccf2d9645ce6299a92f89a3832cce69d6e331d9c65aacfdb2ad872dfc99e44016f59d4a8e32df110212520a3b0651e08e2cca8144ace27c9f3c62199028221748c22ed688a9a5322bfafca895194a6e1c995a664dcdf6dac5a92f0b3cfc4eaea58e7c7dc8edb483bf1d135307117382032a0308cda612599c6f55af1b8f89d15625b9833e1c595a0f0f24824e34ec653fdeac92498f76619b6b61526aa39193f380da4811a8901d8e80dac7ec2cd12c7f50181ea0bdf1e108c89b1fc2b8e0b7a134bdd587534c7307bb9c61a136c131d419ded35c8019c88a6d599383898d17d14edd745b3cd052999f2ea62b7aabaef43761c525ca30e84a0e7758e2564b22e5e5723358ad86dd9477f0d5d2e16c8507ca9cb464c9a1676a5f0be72b9c30786e38f14ca8f1a42a393da8e428900990cc16234b75e9c410f0ad2e5e8c5196507b9969fae83ad78eb3faa3c651a426fa540d616278c99d4cee085a9ed84751d89d6918e967acfd88d13849624f1deaa32a024e777ea08a9035fbd3d230563cf48bfe5ec9c2580ff9c5e47e5126775c60b7995c759bb3cab864f1aa63fccee8610776d8a5c64b1639d8cbcc2055d2dc115dd47d45e4c03b19144e9cf8d9ebe668672cc8912c9a66e6ec8e939040b34c16cd3716b25c6963610a548f039addfd5317a79822c854e59483b8c892ce3201ae915c207b4ee53c333c9253fca0a36c09970e9055532d5bb7dd76bd2db1b1c05296c1a9b1b0f7c6cb17531556b1ca68b124f2987a3e5ef9b69dccf01b951ce5d1a338d456ce2363f3313d357d8dc5a9af922f7625ba3263b8228aa26eb37d2e9505eca9308b9df71399c1cf1dfb862767a52512da2ea52374a62133ddaad9d821073ad4cf256b2a1e7fd019e01af9b62ecabb783226782cf7b05dd15f8dc02d95d9d56e39aacc453bf3bebf9602c038ad6ec152966ee3b9e090bd5f27ac406756667e9edb06b627cf2a526f5abd1cd79d2cf08e4ae000e2d0c4ee3a630145f8862c83b7c6028a363555818d270bf69811df95b710f790c3f2d23727de08a8cbecbc43e5da36d32b67698465abc420955fea4d927579b4663d3aaa7b2714eeea4d9b77397fc1f98e5528445ec1d5725d5ddb6aeaef83aa09575964a7adb0cc3c9690d36b64aaa259ac8758cab6c74b874fd9a5e1fa3c286200deec975473f9f82dd3daebfbd57b311795ed942bf9b9eaccc19d31513b56f7e1e0494ec2cfc6476eab12fbc3678e2157c999fab3c690fdf475dc059a37853475a388a38dcaac4cb987db2a436f94b41b1bbd928ed9e3e5eb0d3a3aba4547f3bf6e1ff221483334eff2349cabdb8efdddb666ac3a63f2cce9bac655e7e671e13dfac8d33fcbca151a71127a7788ff55c0727b0027887bf6079e9e29ea19271f5b0449b65bad8f9e1287dc269f7ee0fe006af6671dd7ddc2f1f20ceeb2c3f5c7755f03fd12ff7f41f1bfbac0ae0affa92c72b8e6b6fe9bfd25af44c6de3ac2ad7beb40ef180a7b92d08a274bb6fafe3cc55acdd12ebd6e76cc5b95387f4f2a3632f7875ad41feb764341ca8e7413395f1770fb22f2f82471f249bab7f308deed31450cf5a7c668b07d917e12e95bf8e85c582a6b0f8c46695adcbd759ec6287fc69f9c1f827ed7b16e169084ecb8aec94542df6e3116818e0e8ea52edb8e7c5e5fdf8f92abf71dfaaee3f4ea8e05bae710f06c7fffb5fe88be33dfda22eaa738320d1d867d4cd5e21ca3ca2e22b7cdba84d7976965ac406638a0da795492a7fb6a5a8940cd0ea92e23d7560ebfe2f9d19b884d67dcebca4687a9d7f443eb537395e12d8d5845ec90b31462654a8b6ee927c632b4cb0e395f5ee47ec18b2ea5922b5382f7862300f155754122a7d991700a8abc6a75c6e4cb8d23b14e0fbdcf05ca22e2ab102c9fdef19ffb708aa47b8f23fb9468b7fa6fc59238f214a1d546cac3213275974f75066c2461571e31a70a10b7f6e02dbd8ce6aafdfb6f48a354ebaf9a0bcdf95194d792ef9faf3d1429961dd7b991c52e768c7eebfcc9da902675335c1ee6879eb8bc531c609f47c9550eb1ff00ae3074227d20a2ed1899a905636f9a4ae9cb5dc9c7d9a11ea82eb7d5538a5f39bb44224700f2fd658e7b3c3f9afc36e47c459c24682cc4a93a196041cd71a45f87a4ff671f92bec865c2ffa483d2fff38f204b025d898d3b14df2a60b9ebd569dde518d779c6f167e2e991ecb49fda2d9afc600a2ba4f8767ee0ae176af66729be14d629ddddca0ed72bbed740bf14df5f50f1ad2eb0ab8a2f9e3962afbe14dfff46c5f76895b525611aa2541e029529610c9dbed9dd542646d66ffdd768b2d8edc7fd9764b26483b7b4082cf1f793fe0bb83c73b8725ef1f265375a429c01078dd95bc632403b7db9d5fcdd942ae559e7433e96ec73779b7702656ea68e389e6874964aec5c89bba2a842e5601449a273e5dcf78b33517ff8b9ef57d2a0eddf4fe5724570ac1ffb72e7f16ea1ea8367ca5fbef31d853953c2cbf41890b614693bcee73d5796cbd43bb7ce922fe6a45a375e1ccf342f4ed9ba9a9ee64429393352648ab1ab087c04e948aef820e544124781b4c0b3b431cc1ec8cc8842ae684ca792820b1909b2efd70ba1894a721b85e19de339fbc7e0d3fb9fe1a1100b24810b5457433bac7fe30cf8ecfdbd93347fb5cfcbee7e8b82885c2dcee7cace153b340e3300e9306b16e9dcfe7f9e537f81af570c5c6742fc0d25e44ce9f126b534e5a808d59fe79ce36f11081d8fc591a58853c8f36acfcfbeb2db560b3b5e9c9de92c72be2ce080ede13bcd05b1248e28b626efefbd46bcca99d4a922767728707a534fa790b10bcecb0d65aec0a119e00ff2e2b5332611fed7ee12d6a774394f19790c827f271d4b8df2bddba2d49b67b870473ee102bf8a93a63a63227797fde0ce6326ae1b77cbebe752fa5dd2a30b45dd3ad9fde463a6df546defe4f95cb07fbf4db9dcffafc663e3f752f0cf64eb53b93f82a57fe8466078bae169e9f33faa3d504247cd9cbb1cd308ab3ac2bf50271e4d1f0ea8631a3abcfcbda235fcaba1a6998ab2068a097fdcb871aade5ebcfdfa4df8dff6cca66bb88d9b3af2f12ce077e0ca341f677076ac59060056d3f4ff098719aab9271091630704bcfb67a84175f6a10145ff522180cab1ab9846731318b0dbb68ffe356d3f896da882031f567b46dcb4e238683c34fca891e920cdb50d0ca48c3c34a238b43d3382439a695eff6aa8c91abdac1857cd7783d088a2e63aff6e59611eeca05a06875db57800b68aca5eacd89e1136811dc57985b14757611ac47e79d154b20f6605cd0e2c345d7959afded423e55830b4d3a24e50144e5f54346d2f36424f014d43df29a11e9d83016007b1ad1d6b2c57a994cac743c5d3f3513ebf15256a0c8ce30d57a78e05f85ca5a4b52a856a07224bc14f4a04d53e29533851299f7d32069571da53187d5a6a068ebd6f3c340c4ff375db332b974d25f2f06a595522a3dd3aa9b13d05e172596319d5b7353770bd57ca015a494618fae1ad45aadb111c43335462dbf79a10b5b3b655600c7db7358ca6b1b7d76bd485ca3dd35793f55a017e1391d5ef2700ef3e7e9c6b5709a27ba8c95d14c7705543ff4eda540f17c5ba7fdebe9f4ec5c29da6842032bca6e9c38943b3f70e486cafd7efd1c2eb379baa1d47467c1b26340c3df281ef2264357da078e6a31f9acd7d33a3aaaa1bd4dfd0343b8eeb6f15edaebf935347d30f1cf3d1f69aa9e282c72d5cc7961259f94f53d1811192456d530bb5ac9099556b3940c909341ff8e169a91928c0882bdc420f955d5930d15464d72556a3624edd4e390c2a04089f4b68c824147052a50549b5b876e3c80fe36a9567c471a86846b5ce8f108da856053e00d5f2f923a1b1068616033b3ea98e6ccf04c61ad8a675f2d5288d340580a6b13734c3dbd6dd4a3c4457cafad888e28c8f96558917296bc332141d519282edaea35af6eb665c16fe3455db3c5ec651719d3305d7768dfca7e92620b603050d1aaaf833f163430f42db8b1515d17a0f617885bb1797e85f31ba6565d1fcbc0eb2df20f411eb85e524048580e04768807251a14662c8471d5d99c63e282f9a51eac50a1cbf30f1e2ac3bf9555343025c512a0753897d17f1db8b3bf9c05dd447296c648e50511c6a3e9ac9428c796844a9a7e53fc7d7e7f3db7868c09e5757d049b9192821126bf3d6279e9d939bfcaa99c46bbc7d5aeea022448ac643636b78ba1f364f484021ce588a662904761f54e083142731ea1d68f403d7e0bd70058bbf059c845ba3108d6ec0598ebebe0d712915dd007ea7c7104d752f827fae114519ddbb06582e0433410bed5db820f4f7e93b8044d30a14cdb90165eb9e72e57694463961acbb8bb03032b42484ec4bb7c3e4ea6865081b2a5eb4f643f7165081a3f085f7c079f07dbfff8fedbafcf5ff000000ffff010000ffff1ec72691bece0000`)))
+var _ = pkger.Apply(mem.UnmarshalEmbed([]byte(`1f8b08000000000000ffecbde993a2c8f637feaf3ce1dbee3b026a55d111cf8bd22a11dbb25d4a40bef18d1b6c02922c23b8e08dfbbfffe2248bec6a4ff5fc669ea917d52dc921f73c79964f9efc4fcb7436aedffaf69f966e06c65efe4d71edb6ada9ae796a1fa59d66b87b5f83d72fe6aef5add5365c5b4bde7b3b77ab29819f21fcda626dcfdd053329305adf1af3fcda9a4ab6d6fad6b225d3697d6dbdb84aeb5babf5b5f52eed742d2816a6bb6dd97472df2f5c37b8a94e6f52a018ad6fffd3faadf5bf5f5bcb40425aeb5bb0db6bf1c342937cd7697d6bf9f0eaffa89aa739aae628e1b7ffd3d082b61fb83b49870218776822cd872224cffc4d775b5f5bd25e3583e4676044bf14d7b62547f5a327643a56fc133a21fae559baa6463f779a6d3aaab68b69769abb53b51d3cfc6fd2d1b84c79bf31815e0e03cdc7a53881760a5a5f5b9aa3b8aae9e8ed2d34f06b4bdbeddc1d906c90a4c37f3650651b29ed2c590a34bf0df5d835be0412c8dbd6ecd6d7a6b16ee3beb842a3ecfdc0b5b59d7f854efb7d6f7ab6e60457e84ce7a03981bb0bafd02149d6d0b54291ab4881e93ad7e81c373037d74ac4a3782d276fbf530cc9d7aed15d266123d5def39079bdd4bd5fa2f12dd3a3dabafbafdf778aabe2825c2439fa6fee4e6f9fda81b683d137021bb503cdf69014008d694bbad6de7a1acc323c394db76dbafbc044adaf2de442b2a3056d2308bcf8e77e07af5c28de9302a3bd3191063f5a5f5bbebb83e1f6839de23a87e897e9e8401a98b606abfa45f3aad7820df92baeeded34df6f6fe2faa509fad9cc11a0f331fb7846a61caf28c974b45d1b997e905b62ca2ef40237fdd196a262716a5b313d43db5d9ed5ec4bd5972e0f9aa21ab9a7dc4b95eaf5483a938090e905a67249d9989e4f76894b8261a99bcc932d65880dcfd22e4fa613683b47426dd9dd998e5efba22dcb66c35bbff2a5e23a7e2039011ea7f26bcd0976ae17b60fe46fc46f440541a95dc537f90eaf7adbd615bb89029952530eb2a9dbaeda40a0189a6235bc5777b2def03a3ff255af7da9e97d716e54501ca59deadf43d6de981a6a6a737e76955fe7a65be9b58d9adb64234b6b1a32c7f403ada98088a0bd31a5a0816ad75809df90a8de433341a7f9758fa49a08f67280b4068200f98d19c0fb861a2892623464af6a9edf069101ef4757e8146f7f854277554dde374c744c55c306621243f21b9682eba0b0e2ad697ba82279273955131892e35da8f8ca0ffdfc47b6dacb3ce4e76c618ae63fdc29ddcc43f633df90c8dc536e8ae56754710215e74b80326c2b407ea9c37204a71e9159fdf0d4f62cf3d4fada52a540922590257e4785c7b6ba330fdaae989a140412a62d6b6a46d2cc0a9d92ef90d967c8b54315531ebab914d3917661364577e5eca3a19daa04dbec73ae7e952ff0672003fbcd24ae175ca1389a3bad44b1f553f120ffe2906bbda7d9d9c7938d6e11d165d7b5e53dd2766d594a45b286d76da021a9a76b6430eeb614ecccd3354a4d72ae91c0122b0892aae943cfe93b2c49b741428ca48d2ccdde0f4c07a44d636f4b8e792e344e538f074d6b6b2773b329d65377ffe5a99bf6c65337c517f27eb39190db36b45d21435bb2ccbd6298ff52dbba7b3e952a547edf061dce756e246bab5aa02981bbbb957ea769aaef22f78e226276d64c5b37ba8da46d5583d1dcddf7c98d0dce7ca339b715e33a9a7a95e8a2a7dc4076730b53f21b5b17d3d7b4ec0e25bb6acee629527e644b9edf4c1a69ecb7d0b413c6fe33fa7f359d1fa8ee3505f4d352f0f7b514d866a0181a4206e6dfaeada966616eef343b74f7aa6b7b8686f77a7db3298cceeea8483be46bb009009bc7bcfe0a49606e36578d16b52fdbb219f85ad04c5360cb590b482c51c9f8ffba97c83d6e4cdf28be864d506bcbb657fd4251cc20a87e95b4b9fa4d6cc6c8bdf543bfbd77cc53313db6e014d24e413b114c1a5fb61543dad992d74c644a8e04a6ccd315b29893dd46d53655cd09cc8da9ed9a3fd84a9ee468bed64c65b9bb58b0a9a7f141d9801255c530af6719ec24d50469474237d1ef1d3399ae25a2b4d5fb60f3943c5452eef68ee657be097692e36fdcf2789f52a9b32a393f2e9ea5ff663aed50b2d16f589e8db536f8af2da948db7592d4b6b253a287c0467556c2d45aa8b8c8dde59fda9e84b42063515477d2317dd0cd4dfa3ba962b43c6291286f85c40f1e9ecf29b5249bb9475f72b2cfb2e96b4a904b09034d42b93cb23a749aa8189262484fb15e7849760f1a70e1f62e50dc43ee8db7cf3e26d64f64065a2edd0e626b689aa4bbd24e31f229892e5e4cf2f369dac9d37666bc4766d2dd1c9d5de815470b829da4e4eae5fa78ae64933c17a1dcf3ce8556ed34c5dde53aa598d74edb204d098a4ddfed1d301fb4a5c0b54da5ea8da2efdcbd57f5463b9981e1ba56d53bbd322f5d69fb8ae454bd8ae5ac8af4c0a84af7bc9dbb89c487aad77e58999b1ffa8a84501b99cefe9425f0a58db633dd5c92e9e848db205337722379b1986793c0745eec5c3f7472dd00cf81e6e7738b6ba49d3445730e55afe20d264d872c226bff2509863bfaf740655fec1d6899a149f1528abd061bbfe03d3003fc3eca16b9b96d231e9a7824e03f90322e3f83e46d621f4a7fb77165ecc83e05ffb5ed3d0a4c4fc28b0d27fcbe77034df576a6134832b6c4d8a03b380a6697c894958a24bcef06a1a7f985975072dca25cb26647a26836d1ff3d1eaf72623bf250385a5076a98077053f27cb354dcc745929ad2df98a6956be8127aaf64daa1957bff63787f89da305665247df4eab0b7b94b77303b7ec11727d3cebf063db37f568338add43457751cc3fb0cb54d74e5efaa3ed874e20c1628a17d9e5575bd1ddcc53c23f7d642a9adfec828a970efc77e14df182000f156cf1994d2ff7dcf6a41d9622e2da5c2480f8577b1f6cc887fc339872f68ef9fb3ea28355033ff6264ce883e6a8eeae5d2585467b1245dc46e5b928243b44ef0a35ce1ab6c05be912b36003713a6d12dfcc2db457ea0bf34e75fcb6eaf8b6e6fb91cfb48e305d38fa3ef06fa1f376ee29bc4248b50d4f52ac062a5375a49ad7c04522237cd55b3ca77c4dd9efb4b66caae66e8fea9a5712046b8992190719de42e74482e551932cf0c8be6b7e9002239c3d4251528a5b8892de5c15eafaed3fad9b30236f92e924d88d5b21298cfbe6aad729dbbafb5be4e8635c4edbf926868490bf5164ebbffffdefd716c82ed79032dfd2858d29015c03ffab5a20813df4db7f5a4e047ab9907d6df960e6fcd625e88788e9b7be5164f7b1fbd425bb244ef937660edf5a14413dfc8b24fe453ebe139d6f3de25baff71b413c3c741fc9c7ae08bba4ff6fd0b9e3fe01b902903bdaa1f5eda14750ddaf2dd6715bdf488a22c9a787afad2900505adf283c0a5aeb1bf9f0f4f8f8b5b532d5d637e26b8b89ff17fefd6f4f5209fc7ba1426ec4d7d63253e93eb2b26de82357b1fcd6b7a7afade7c0b4a103969ad2fa463ed21445d044eff16b6bea430a4d741ebb0f0fe4e37fbfb6de2a497b0969dccaff7e6d0d6e2514fefdefbdb3f735b5f5ed7f88afc457e27ff1388243fe13d0f40968fa04347d029a3e014d9f80a64f40d327a0e913d0f40968fa04347d029a3e014d9f80a64f40d327a0e913d0f40968fa04347d029a3e014d9f80a64f40d327a0e913d0f40968fa04347d029a3e014d9f80a64f40d327a0e913d0f40968fa7b039a625e03d5b4f45b912de92af75bff8dccec49ab3d690732579adde51b5cd66d90a9284ecc6f205437a3a73274097c8a7aa0e8043ed5a5885b7053c4e303413e6671531b09f95780531449a6c02932014e753ae4d3d35dc0a9a8baf702a79e129013f9703b702a6ae60dc0a994f003805399995280505d664696a40c96bae0a12e80a768aec578a778b0f280a72c7629a22e2ccf284ecc65f57e2ee8684167d75ebab65b5ac7d74586b325be87d8c1d850f985a70e587d2310dfe19dca7081c29c0c9559e9acd337448a63457e68b1669fdeccdda7b54d5bda7bb78e762af23d32a195f9a1279bfdadcc0ccfca9930d714bd5799a127db5cc86ebbdf07e6b33e63169e9aa191052b780bfbc7c9fb9bbeb6950097b7ec877287db8b0c67b1a3a9bbe617073524cff292fd0279b083e727914114cb4cfdb5303dcf966353ee8c2d5160e17b4265e8801d8d0f6a98d2ebeccbc99284b5bea64e86d279a3597b7a9007c4e9cdccd00ca07e7a38b386a4c8206236a0ddb76df77bfa6e40431e09fdd3845a786af2fee564cb9d71c032c3adcaa083bc25cc0915e5c39afd401416ae4ccd69d65611f47f5a0f07ead97726efacae8ce6c1dbd53a93813820ce6ca65ed01f12df3baac25c5747e828be13a6427186624f5d36d3d7a24d87f292dcca548f506cda67cdfe7ecd9368b61c9f58b34fadf91309df6a1d7fbf1238a438d69e63b8ae3a2269d6ec1f6566b81579e320330ba46c09737529c3506c644cf893b1b6397fb61cbf8bfc305c53ba39d3ddb48eb9fe1a94e7c29aa2fd094f5a937736fb1df4c359e44f68dd9967fa423c2836112814e78bfc94c8b405c9cec2534796c932c350e25f0399199a227fa459b4e829cc2a9f37ae077dc46396e90376dbebcbcc31ea4fe1ed6942c534f97ed7d951f0c88ec6866cab881df486b86c062125c46be26a39d0d71b8130211f895feba2f0aa4fcc676b8286a4288c7b138bdbab0c223481a493b68afce251a3b8bd3822e999133c4e1c929e0de883325a7832d5bdcc1ffcd77fd4c23e92ed395ef397f4e7a749678a6486dbaa2fb573bc690e86c539988ec5655de2353111e27206ac2edbc300e6d89a9f12124fef2be64e7fddc1edb86b9c92fc94f3f12077fa38efe2f7c0c3147bb817a9953eb1e275eabc15fb05da9cf4a5aeda435fe557341bf7336bf61f350ad92cc39dd841571f0f7a0b95e7426dd98bebdda337497b19ced23a0192ed05bd79ef3d6a618fdebc37d74fe47b163b22e9ec18ce067432ff33e3723c000fcce451e4895b993a06323f0fca3c0eed951147b023bc1e68d65e004f3733df46e3c64f0fb2b30865ea68b24ccf90a11f6c31942998afa2218f38e01dd02e46b1e900cfe3e29ab322fa0fe2039eecf44975d02734a10f655b6b61816ee471841cb2f9f1448b43659dd1e2c06e7f6a4d9caef165894196c870fb4cbd8c75677150cc62dd92f50ff3940e3742ae0f618e9aaab02064aa9be6ad743853e61191ed8fb5b3d8b3cc98904df22cc278da0b43712c7386a6682d8cb7d2cbf1b07638d833f7b563f4723ce07e7def7eaf6eff3c10ed13d2067d42140c62c293a48af7ee3e31797fcbd6fb496586a6ccac2edf3a0b636d9f50b1df54c6406b3e33df93f4517e2ec7f521a441ffbca668dc0f307fd86d4f90186e3f1bd08494e16fc9df6cb470d9d1742b77f03c82b562ce90eacae7e34165f45bcb4878d5d3a4b37033ed4cfe9e5446d7156a7a88d619cc13f60b87c7ebae72c68b97bbe85f2406f81c77ae6c3fe455e8474cc7708698a77d5299f1411c598572f19e12aef99e232e9ff70b1ed65acd9e375a84d5fd32ff02fc6e825457e4bbfb05cc2f7e48b003f6f8663e1ddf964fc7a9f97c9abebbc76924af421f5b7579cd98575d1a8d919891bb6654b4766642f0985b474ef0c80e30bf3e2820bbbe1f0febf76e63feb8aec99e117f03e5e6fb05faf644882fc0eba37e9184450ff839f4af583d0f2dd8c3d9416fbc185ccbb7fc7d712ce694816591aab188f381fd3ceafbb81d857de4f207b20dc3edc541655e95fd05ed54f26b39c9c757a855d55e5ba68ffb8565a60779343daef92ecd6ed7e6ccea1d5806f4819e230a6fba68d3a46ccff7957d5b31c79b65a18529538b5ea63dd03ecc9b92b4ec3e90fede1e0f58efd9d6ea488c4cd144a2234da8783d6d8f0769b408e497eef7dcbeafffdfffdbfa286b4c024bf997a4aa371865cae4896da643753a77da66489278227a77db66a88fb0cd44d5fd536c3351336fb0cda4849fb6997fa06da6bcb46a4d345b559812324522c5240d91999b982dc016315a2059e8fb58e41d8c8f6b5e454bd8b26373ce2cd9aa6b68df796eaf2e135ad290f86e4684c3a2be017550405dd0dd0a318fec81994601f3033376263c2e4f57301b5e2071d03f4bccd0923b4aa06444fe92786fd3a1c870217caf8e1607199b7b14f39a2af363501059b0a9a687d4b0ff92f619b0d5970cdd4b7e7b4bd9764dddc40169cb0cd765470ba48de6812c7084c8cfab4c3315e69e29a974160799e74225a4a3b16348a432c6417c214c85a1cf6aa10da52dc3bee4b1b0119299c599359ff72595901993b8efcc54153aabfc38908479568d3355a6ab830a3011faa1c49391e86f0d2db15aec8776192c638422bfa65993becc45e7ad26df2902339fc80c436dc97e9953c3fd9ae2fcd9803632f93e4d286cae49da9e552fd2dfb3011dac7904f5f9a0add40331d51279d193edbabe2d8836a5398feb1d889df141159eb373341e87c86cc132e2014c62d8bcb164bfbcc3ffa0ea476a706eccabd44215cc6c59b3605c2ef4ad3a1a1fe48ce9242e23af2e5a97b64d2c50d5397ab36475c5e64e2a8f42b1a04aa5eb209b565c0b20922f4907d6b75298b7d086b86d208685910a451ed77caf07ea47f27b36a0fd353f4672493c2ba9f25875bc5195bf945530a5cd3bdc19ccbfa2c0eee7141d28cc700fa6b3a27a99997f753c273681a8608a3e16be877512b56bd0b7e50e4bb33607731754c22116075f6e1f7b91075e7c34cb6557995fe2721adabdc0f99d60fc7ff5981f2586de8b4bf6cb8a310eb2bd7a9a50b119afb44e0a26276781e41be7bb12e55d34395d78bed99be37adc34ce0575895f588acd9d158a3b17792cf0a031f3aaffd81260e6cb96d7179945280ad3333be896540931e62da29031573b255348dc2f27245258c5ba8c81f3164ccdc49432351406116bfee469f6ca4cccbd2c33b4c4d118291d304dc77994d7d85dbcac627ca19fe90d479f5550ad8539988d08c5e6905a3fbfffe8da2e95991ff7853149ea302a8d779559b4729e2b36dd297d7b753df45393eab4bc362af783c8bc4f6ed61422a2fd11115afdbe50bb56b4d1fc36fe10cf0f819a462e9062df59908e4abca1a9efaef246a6d62d757d5e314302f38f0e3641d7f28f0f9d575199b9fd73ccbceea33a70bfaa6f8aee926b7d73943b53624dd116ee1f901d99e941fc73fa285b76b99fd2ba707f9579b40577b13a1a47b247fcbb56f6a8d987ef91c12eeb9a26610d6a15fd344fde95f7a5dafdb84e369950a227f2274ba13822d7af7526ba66de5fd667aafb9758f3ea99ddf656f0ff2f92e5a23272f24c90eccf96b43ceae3d11cefc13919172d0cb133a53742e4361d33c0d38e7aea6e1382c7f128fa1f4c6b60f62db45567477d7fcd4f91c4d03ee8a3b3e5f845a6c860bdec9f45a16f28f610743f9f65567b31ecea2a05ae6feebce64f6832e85352710fad9af77f589fa0095178c3a64f95e19ac600aff3b53046ebe54dfdbe973b0ba484a023d01da005e84151967cc7e54f63b334d755996128f2afd572a5939b8bbfc08ca9dd062eaba04f0c998fe4c3bd76ccee43977ebadb8ed9f9083b26aeed9f63c6c4adbcc58c99107e9a31ffc9664ced3ad42c6bc744310c8adeccb14d86107912e04484b87c361566e8882becee8d6c9ddb53ec8aa9a32591ecac125ad087f7199902cb3590260a183ef0bd42ee08b425716647cf003d33c590c4e561c818dfb3b0bd8f320c91a13b13611aa47b7789978f0f8abd80f69972075cf1845ed42b8afa04b86727ef395814ec11eedbb657d4697f009fc66e4f4acfba50f3f2c165cff9c3aef968afe81bb14bbb68ff3b88317450e6394262686bb61c633931dbde1b210f9e3868b25fa1d712dc6154b2695fea66c5b251c8ea93b23def5e98c451b151b016b02cf9be1644341bd0491ef97d2fe772bed41ff6d4cc1c2164b30ff65280aac1dcb4e2bc5fd67c6f2bf2c7a70995d94f4b508b8c4c7119eba70905369e6eadad7f61d38704e609f58f5d9b4f13ca2064fef8ebf76aff8e7ddacfefd1144ddc0b04279fc84ee7ee3dbaf7117b7454db3f6793865612b76cd209e1e726fd0fdea4fd9b3768e7edd3c9d8e864cc30f773b383b12edfa2b23d0305cf2652dcea6cc91ed6a9e2353e5760c673dfdfb8d1126b5e291ace13bcea4814e63aeb2c0c311c5719727e19a67c4171043b189f58a719573e31bbfb1bb1e5e58db3cac0529a6b60702a6336d7cbbeabd89c5d1c930935b4c49c8128b3b98fc69ecc6327a80e184709044941a75993807e0865ea047d92081217276c7d7df3821e08400cb2d941648cc9382b8af3eda8160c68b582ca993ecd842cd614ce263c9bca12cf876c3b0d9151518251976d9a60077a713cf15cfb2e90349ce348fb031b604e673149cf0841a9b1669908a70b7026876b01e3bf03d98e9c4b6bfe547028e79d381c33740a78b7da319c755e69ade35bd56d16a10d748ac9bff4a9b9e6178eb8cc63bfbf0fe67f5a1fc475ca0b8b15c6bffc1caac6bbdd0d7ac8aeb3d8d8b4e6c7be983134c19c972bce56346079ebf1bcf5b856ac14d5617a6b71bdd6f4200b7d4376ac3a9c6e2d563772a61eeffe2e7588def91d9ecb7518e49fc72157e332e3f40adc6fd29789e2904baf3e4f91e12b75d8d37a3c700396379b2f8d0d09330a59986f258a2238bd12d0433396b788e9cd8104ea30ba45ac2ef0c0891539e2614d5e78cd18a7a9ccd303acd7ac233ef78cebd8bb9ce1b9a75cd47765aa870ae512227fc46546f38e4bcb4c9f7fa6ccfafe2b9e7b2aee49957fb308cb5bde5798b1530412a5fcae83bff9d2946f0ef45347f75283abbe8aaf4eff6af79409c55992306f4bfc1cef1dcbd5a2b87f5ee4840eb75d53dc5909d92f33c6cad2782c33f626421fc926fb6536a0bdd9362f1f54fc5d37da309c2f827c9e95279dfe41e9e41ccad684477b9941b0d73495a7b30cbd4f0c28b3e538148505a9d80024a4f7a28d4279a93b8ba4cc51765d3e39dfab9c85836699f336e35185c1a8e2ef6ed01cbfc0f35b31ebc66a11ae85a9cb6ebb4f13cafa5267282afecd0634061735d4b79e7f55e2dab3ebe074169735fcb7066b9fce7f0c70389d0134355bb214bbedfd90c37e763e3563ee2f75afe6f1b5ce4a589bb15e99b40bda19c91455f270fafba7f1f77f86314c957c4376a59d7a8331ac409b18c37a2479a731acd77ba04992bad31a46d21f620d8baa5b630d036fd6c799c3e2765e37875d083fcd61ff4073586161d59ac32c60e56b9b3614fb13739f60eedfde8b1807ecab7acdf455b37faaaa2ed807c602abeeaa03128ea69b0d18744361ac83ba7d3d88ccf02c31e3c35a185b35be9b832a2c7ce8cfbc38198b5e602eb4bcf777a25bf6b9644c7845334ca15fc08c98d47d2bf23d4214fe60e886970c9d9353c131de52a6a63b76189bf006b43b6dc0b32ef91e0575923be3de0445df14c5e219a31399b001ee34b78dc72683d1b4fabbed338cf72d18a4dbe6010ef750c0c5be9cdc69862eeeeb401560beb35fb26d645f11f892cfe57e817ec5474cf37d6245f4b15f35df8e97d359e687be3ca82fbb941fe2f692b0386f044257f99ea78ede20ac42804d56faafef8b85302425a11f407dd857b46787dc597c0713753a7ff3636ee1f3004719ccf483de58461c364bb183271dda96cdafa1af7e597bde3b2252ec05292efb6f3235dc2be79ab66c733c022902e7290ce002400deb6d54618a663c4d89c2d852f95c688306335b6cde1bf48699fcf6699d04928eeb6b61d3a3300675da57288ede08d1ba052cb9285cc273b0e0d7a74e48369f0b7d9bd42d51cdbbcd7d9ce55197dfdf6bf853dae7f5e392e3b91f838f18fc64689ec6fda7100222a685b5bd1460bf7dd3d78ea52747ea21bd986f71aed6a5c5ea469617e3e3bfec280a5180b1200c598de3af359535f167380bc1c578d58a30244de6b2c85c9133494899bc671dc87b85cd11b86f84782e76540f4212c465637c24ec03d8143f9a62b392c8135ff07a80a3e13cf12535515f33ad252a647e6d47fb3be63d53983f44d351fe6ad5b17e4fbaa2d66233dfbbfda44bf15cd1788e986ceb8ed8d7a8d6756a6b6c3e4d426465d24b47c71bd6f3f72bf242976de0b33f193eeb6765c4eaef80d7bfbf56e2db31afceed39fdb16cf639855a15f95d95ec559f16afc90cbf485c12f1ffd0de7e50748525f3a5c1e45dbbde7ec21d516de2a78cc3bddfac28b45f530dee84eafabdaa7ced37956badd61df072aa9ae7156e809c7c11ede1d5ee0070fd005edb13cde7dc1e5be4bf1733dcf862bebe91278ad93db7da4c8b65447c5e33720118b33afe9031c543d812b9d36846bf840d41534fb6a7686281cb9f34c0c5faf6c21ede5e5e0f6f2f6bfded7df5f0f612e99c37e567817cd463c084b80617fef6399c9c9f4f93f33385319631263e27632c9f9f944edfc885e7b38716b86e806f8851b89810cb2b61ef752da073764c58666168c29b2e2769efc703ce6f7bdda550e77aba62aeac750d964383cce3d020393ee329611fc211920ab5daff5cdd2a5d0a55a142aacc95591e5fe263e9efbfa4ce5f0abbe54e5f7a6fa0f7ae3a8bc39af26f97552bea15f713969515702750881297fd326ce1061eaf8ec69e82f1ac1919ede34349bd2fc97a3e5ac5af93f3df77846c5aa8fc300af577df774b918f6c3becb07f90f81e715fc8ab318ae5737d2570461c3af1beb6525dfd3dcae3aeefe609f4a9aabe15b2d81f0951f5ced09d78ee96e5ec1af9f1c2eba713aec13d8ce9f2faa8273b2284a13c288e15e1a65f684f7c21017e355e247a6198c578bf7e69cc3f0e09b78e434a5d0b87a575828e242c5c7670991f2b86f664679197f963b9be319455aa332473657c99ab0219c365c8ebfb40dc0e9542308f3db05f6a83676b62d1fb78fee8912b0ff753f6ec56635b7370814e9f84b0a76b618a2e31382a5c7b58972be1bdd3be6a90032ae5a6a6bdac46a684ba83dbd48ff651c29c866c4e57590b63c43227080f0b2e4b70376fa5b05affaadcc7eaf4963fe26efb7b84f02566688cd614844e3df5d8217714e1ac093fbf6fdffa491da3d21690874b2c457e0ab6aa222faada934a731cd61bc78ddfee81bec43253da8edc599088174da486b556fd3db78f6da2fa04a57dfc73a1fc2af87ded9aaae2d758f6effd903b0b57e27b0e3b520d25ec930a83cf745bec082175d0bda3dc82ec575e33791dff327732716ceace2f8907b913fb98b6c743a257fd2921e1d21b082f91a81addd215f4e9398d6e87bcd335dd211f3af79ea5c487263fe09c06aeed9f714e236ae50de7342e849f8ee97fa063ba6269d53aa7b30e8e60cd2facbff361ca4a21825f6c2106b8c6d3a43a80a053c81248da93ed8c73bc4299acf96e2bf2279f65486b1d92fe5a40075598c3a17b4f1cd21b0850260f88000b5e662c40e86efe5cc64519873cb6d8e9cc4c313820dd1406cf6024d826583559e07c9541c7b53026e28067016c8aeb259b3be791374a45670aaa0e5566cba9e8775ca738080ccc8bbcd21e7f23bd647188b83f00fb76103b6343618c1ecb94d3264bd2853b20301ef0fd2dbfd10e9ef535350cc501098a880ff8fc779beb448e585064f470b63d99a50dba6eec047044d08e620f8fd2c8d22bd282d80082cf690cb669e0ad623ed80117055f9aeb1569c104e6087382fb28ac5860ab0d0292099c56eabf48f8890e9de2398a03aeb0dfd941feac8acc70d6dab168d6c6b1d33341bca2673c5e2f11de2ebb9e44e60d1c92c49a329038b8801a5221f38e6f0492f6612cb1500438c53c9eb66414c4fdc6f70e2a3375457e1ec81d8ec0f380a337519d72e76e6abe177d991a125066d217acd9f7c49734785d14206fd93f88669f9098958e9d79a951316967f1f9ae76138a3df470dd016b3ba0f1b789b096e49b0da851586f20541e67a80ffd7716974738d7b313977d831df5bd750770af2b5da6d63a9c6f60193cffc198714c9d3ac5bf5881513a8b9e7c09ec0460014fa4ba0f91c2c2853fcaced1dcdf8c41811846fda98cc648b51152e1d0317f22807f283647493cd7611914000889d5dddabcb27f977aa99e385ab83fb6ecf16dd4effe08fbaec823471acd1fdeb6cf4765a43fb20c6d4a36dce940da92307f0083ac6a73e18fe36d65c159299142fb1ff6905499a747762422c559786b6ae84ff8a12ff1eafe874d7a22b3f0c577bf1cbca5f2af7f56ecb7f83e83d70708782952ab83c2f49c1fd4d850a815351d9c3cce1acf568e725c12aa2313c3fe7c35fcb1223977c171ce7c05cf1ca4f5e7d6b4bf82ff576fa7e5ebb0338f685fde98d370c589cbe9cb3878e7563bf16cf4e7dc7437b756bde90b17ae2c12e8208de32c7ab93c732ffc0a8de12c52058fce2aa24f6be7b2175d0d0c668b9e8cef6d1802a6fca8be1066d2e6ef26f0fde1511d71a1b8ec87d19e3306e3b1275ee8323ce99eb9d23fc8761c87fb9d304586deaa3cc811737022100bfe84b011fe75dc5bf27377dc81f2454f1414e7fbb20763a4cfe66e45bec5bf7e079452b9a3608577624f43911f12e22a9e7b436ef93e30088941e709ee0beecc9ffbeaf765651fe695fde42fa3b835ecb598bfe27d0fe487782f57aad66926e04061df48d3231e9b60d18900f894427156bc17e37d5d20e904d35fcd4baa69834bbe7d5c67e04d32bfd2b301602f81e5a6e4da26bd8ab3a515c6966c1b7a1ba133f694511fefff59c746218f4a7e9d049e85bd09f35e6c0c5e58391e9dcd07af8fb85dc5f18bcf37fe65fbba1aa464ceaca9014e41b97006343b4f076621786edc2fd9fe4e7f6fabe7711a282297debf6e9848cbbe1664f79718271409698e2aedeeb250e43f4acc14dd0ed5bdcf4cf1f0f044d12479a79d82a2e88fb05344d5fd5310f4713baf1b2a2e849f868a7fb4a122bfc26eb45650431fb8c55a603fa34c344599485d63cf7bac0dc5aeb5049a7281e8915978aa3e4ffb37038f69beb9b0a23fc06d4d10c9097018af54db8cdb9181d5b433eec53184afdf0864cebd397612d71d09215e43357a3707cda478b2fe72da2ed1ce57b913f270ba4eca9c345fdb96beb6ad00343ed5191f6493f4455e2432a7f08cdae3045bfae2ce8ce0cdf486cf9e26beb831ef3b62c07ee118da5a0b56fe684121fac2ac531ee33c1c494ccb573a7053d73c9e03e92d8c77d5a9f2d4223f7545016e65eb068a8db53a135c9dd27be1580476c144f0aca4aed9b64ca82cdc86de42584c7cbb985913212337a6f8d63e5c5eea869ebb2509a820a157aec10f8493c42e48ac252569e0e28b8f0514d6ec6881ad6433fd56776f190291583d2ae11e7997218664e55c524db0905cffe48eaac42ec30b14ed1d6e61841b249971587c17c3d4ccd865e7d64273cb372a7d38846365d31d25bccbbdfee5a6f9babd40338097a5a789715f008f206e8307de315e55f088627b2bbf6d3a453f4a22048c914271a16a83058ede6f041220fc9e6c8b8794bf33c350eadc1495c49af0631f1f41624873f2fe5a6389e947d6e038d20f4719878d403c40940008d78e2de2e57995421fe1f8cc04a2ab84bda16c57b99013f7f138856cc665142d9ab9bf9ca662de1811e00adcfbd7dc6ef64ac6a7adb3b253135cbd0186526e6f154c1da7018fcba635456880909af65372f40df8f88eddf6e01a04a43822123356d59bf22bec5532c3edd5621e59de7ff9fd3d5e2b39192469f3df059e925da3cab9707425dbeeb2fc5615f5213b6f0eb0178ac298826b624a9ea54cdfe070a58c12bc9915fb65c9da68803516a253a461d025be783d43026fcbe6537704a97434ec8f8411ef48cc53a12ef384479815a1be97e9bc8dae70a9b805361923b01689f886d6caeb740a635527f3e39b780b16c3b245172cd71c21f1249af0a70358c36f6c7f246743743261ecad9764ecd520cc5524479aec28d6cb6c2e0bbdc9cb5a7f6cbcf06dbc2a83027149c29e61dc7ac50be6d1e5486fb0177c40bdd96bf5be679e5dc2a643a8768a7365ea04b0359a8d43b45744acfb117ff37373e6c293e276a656c7dba29864ac9117beb3aab4a816f94c6a79342beb684d78d117055dcf1fe3bc47f6fe78991a6487bb20d0d10dd577419739e6e92efa288cfedb5ddffc09b068ab282bde2b5fe7e5b085d54417f3a18c6c379e8285beea28cc2df92df89e55d2635e3247615e5ec91fdb678275ae439227f1dabd566e9d8c0911c1e2888ff8aaa838b433d8185e8bb258f297224dea90219d74ad565d2398e1abf340e27b798440f1afccf35c895f5822dfcd7a00ad42c44e2bd17f92a35255edb8316ad336ee93abf2429d37edc6f0ddf1d16cd69c6d4f5e86df98d2b26f4a4b12500167699089b055750d624354d19b74df785d8a37acdf349feae3adb5c7540ad19a12fd8154e03a2690d5c37e229b1b2a830eb2f3b6bfbb4e1f7953b259d04ffeca37275f4cde979b0e6ef4425d3e483c50f4d353ef4ea0ec03d1a3efbe7584a41f3ec2031555b7c60305057e2054366ae70d50d994f0d303f58ff6405d56d76ddea7e4d20ae0d0355c86058933e1328995a486762af23d32a1c5dc2bbb0bc165adcc10f08521fbd73c2cd32decb44f10cb1ca4296c895efeff76e90892ed692299042a7f2224b880cb991f624d0c344b8335eb2d1ec54376594b6e85f474e9a70acd317fe963a64fab2f49c39a612618408a27bc2281dda57542de05e9ac3866052db7a4617ec06564117673351a7b6baafe22c23f76812568abd3bab6ce151b6d6fbbe8f5ca6583572ff48d31ba16b6c22407553f726c33edcb78d99697a04c9976bf81352592e2729e6090a05770216b74086c157b3589fd3b436fa338eab98394d89a02d64ed93ee50fa8fd8af9d2e10881a26da183a5509833e6cc027c3fc6fd620b258cf52f5a33e015ddc894b889b428b42d5e6699f6558723de29f13da94fadc5901f9e65aaebb30c1db220ad8e163d1c939f417b769469e3b57e1d15bcc0cbde8b2a8c43f0d0bd33b40163fc0bf853140ce423d76c5e9383fc2b3c374fc7a9394e2e2387a0517bb9ca2a41e1bb0d66802116471c2a695415c1d9e27e88d6dac5b39504b248f36299710fbc34130b82584cb365e82233ec2961b72a685bacddc79eb0f9f5318dd7d8c7f12027c5999b3394fe6e1ac3fb70e14deba890373be89fd511c21e3e954296cae8192cb881245e75d517377c7be91fb5175f97a9f1ef223fc5e724d417170e3a53a2c03e16e62ae0580dc5563d79eb19aab038fc08fb10188d90f8a9210f48436690236fbd00ac1532b37aacaa17c67c17e4810cefc416864927edbfbde4f41dd67c2aad9bbab32080d060237c71b20f273cc696b017b97021bfc9ea89056472becad3701e57789ab1a6a64819cd539cf62474616d1aca08b729ae633aae49fde0fe09a72aa6b74cf5c6b81e2f3f85dfa6550aedf1992e0418ecde16d6b6ca70b3b5eda17567c1adaca3ab3286a7843ddc3e85f48fc212f0b0f47e4e19d84b0e71c7656a4ac6c1821c51580c6586c3d6afb983c6e24077441b01ba09f6628c1d1785455f1d2d420970ceccca1d77221e340e8ffa4fd6c9bb45964830cb15d8e96ab44b72f7075cde4c0d29080692e4518b4e2acc6145602fc18a3273b9c6f27516813703a221c278c3ba42702640a0c6fe9aef81b776b132fb2f32b5408d28a9423d3208be9fae0fc63b33e0711d8677945de3d1c87b8ee7d4700f67fa0af996bc1799714dc7f86fe331b600c7dd23f05ab0a7a818b8356b652bb4b54ade98a9c26226a24466e1d2fdadd81780ea529cb91eff7fd95f93408815f2dc0f132c9a3d1c5824bb4f16f7d2bbefa1b9f45bec3dba7c1ba3c00ab2574da0b77a544645801c9003bb77796e16029c65b2eefae69d199ed5413f9541eff360f540f6b9abbc3965a03515808c7c66875180b2bbcadc56a2d6caa814b02e570460aa0b98990f26979ff37759c72bda1159da7b739919eed3a01973b74013217ac0a33389c67ebf4abdfe0b7af35e3a8398dc0d0528ab3ccf73329eebe46c4b8a8c25aaeb17c9c1af2acf5d2bebe2e9a809c87633e2a9a9bfa2fac47d86e567ac4f267db300f9b99304889bd6979da249fb06784c72df87cffb15733264b3f72231dc56a268521d4de14eac8362f66fc8b3e6fb4c3db3f31dd066ebcc73a58c7f99cf8d5ec868ddd604e3bbc1f398f0e504e10b726bad0e75634024c8a3d0de46fa9ff34ee2f1fb7fc133198a3c79c05ed6bc3e1bcff9180d5aeebb9f43637cf09d320d77c9dc1b28ea0f2218d77110c622cf8679d23bff6928c6826efe21f3d801b9adef4cdef1196b38ab1dc441ba82e4cc76767e976c293722e7b236eb4c9fd42283a605dbcf1fb2bb26310aca76af681d0cfa68622b7bf615e478642a3162a402ed84e9593373e6b85e4ead9181f14509c580c2a5b52c3b5cb0065f8bd90f243c079f6f6c2bec556f556d1d826d485bf62d8cb62fb72dff7efb576b17c46c98ee20664335428d03db4a8f65f27445c4602cbfe6f6c88f6a6b612e276dc1f561b7b5b692926d04eff3806ac77b079bf65d5c6753eee8977ec9c861491c8a0c92b3c18e5d5dcf643eb06662a7cbe40f01392f67ed4d9107feb3d255a1d6ce9dd7d5fe60ffde6da7289cca1199b98e65a96a644cbeae7974ccdf4fa7bee8bfe7bbf4e997139eb72cc3edd771a07dd6264979b4f0148cc2a5e3f90268de5740166fb1efa67c0a25d599afdccf07a70a753124c2d887fa97da8772ba39943b9a229981d820a5757b16f9135a77e699b599ecbf45f475949e293f6e137dc47d99910771e04ea16f481080968adf57c93897d339511bb16ed0ab3aa5f32477fad1fe56e89beae0ad0d65d604e05428ce07fb78b67f7373ac11d17c1589fdd746348757e49634ae4989ff56ec79434265865b09db2a1750d7043f00f6a162bdc1766cac2903db989beb9feabef17aa049f595b657892f5120e9669ef317daff6cb4cffa60590659708fa68c72fc27ca93caa1ec7f6e3f2c9437b3c606045e86cbcf525fecfbf110aff5fc5c28ed7d55e352b007d4f7ffe90fef8f593bbecd6dc1a65ba99315e64f512ffb7bd83a5557bcd3d699ae87ba53acd541cb077706398ff8cdf9ae6ff26bfdfc27d82b33f244794f01dbe3b375c1223c3fccaa4f7936d83461df7986534d48366b6c4e09eaddec5f0fec9db1ed612c497c323e8364c7ef52fb9c930bf61d5f7c153c8e2fd8870c5d6c53cbdc7dbfb2b9e39a47e1cd81c7c3e7fddcb1aa0306972ec588f7aa6b01c8cb79146583c21e513e1191fc359c2ec6a7cfb23eb24927df4f0b06851b8138c4f75cd7f9eb523f4af53dd0fdff8fbd6beb4e1456db3f68d6b736a076ea65b115b16a1515903b810ea268dde3a9f8ebbff58604022408aded74f6f4c2359d16490839bc87e77d9eaf88408fcf1427941b96393a54eec73551e75f90fd63c0ccef2db6a0738ff2464524e5ac7e805d3899d1ed523e0ad7f7405afa29bf03e65f4b65e038b3efa9545c95a5d35d14b3cddd13b8532d53dbdac6746f039ecd3895b6091936ab39576e3d104b05ae4655690b0c2c49b4272a8da3dbba3b8cccd1616c0ee696d93dfe32453afe8cfe46c5e9c3fefd9dd49bdcbdf62677a14e551ea949be27f7bceff4d553cf333cf3f005f90a167a9de4e617ce7d01a726da0b5b8dae236e0367dd8cf7c1ecfccbd92c247fb65a1c6d450b9c2c9ead33001fe4609d618f6fa8517caa8bf639a706efb2b119faf2cbcc18d1dc9a1e540131c69e41320f78b3e47913bc24bda7c84f765451128d21b0798ce52dd8fa80518133a1b76a0b97449b7e99c2817e6efcae1f5936617cee8dfeb76dc24261978276ec6af65da1b819ab5f092696fd3c8cdc08ba2e5791ca13434bdb11ea1c74f36bdd46a533f5da361ed850f87d14098c917ca4da8af695cb3617cae51eecda88089225fb84b9ffa9b68af0a0aa47332d15f629df7eb2bfc49848fd6a365aea9e80175680a75607fce4afd9fa7561af77fe30c165e61893c827bbd6a94fd5bc1c337ef4560197b78a53407cd455c41dfe5e6ca37d40c5dd7aee6ff6cf9bf9c679ae547897fb1ea9bf9344e9b662fdddcf46e3b65695005210ae517e17f5f653842ad0539611aa882ffcaebefba7abef724bac5c111e2a94db6800943ea000e4370d24a28164292df623c3061597a9ed386050b620efef312c575830a28201471293158cc4fecc1c08a971acd09e06899f2a46ec8a4eaccb9339501e4c3e21f0880cb06ad41d0586110a0c0c259a16b3bcd218b93fdb28da11a01e499ee5c6954abca0e0632f4892eb6094660527382ab614f8e4bdc04830c8a379c47106fd0a6a8c4f9098c3857b6ce39e0e9e5e34eef380476c1833418fbd9006c05273b576b1cdfc7c2d52682b9e7b69d5c371a47a9804c6e51514927d070dd341438e2aef10827020424227622a51c9fd05944e8534a9ec7d9f146b7e86a39f29a6a84603395c728b5faea71ccb485e575e5fef4b0ec09e0c94bf1e0dbe5495e09c299efdb3ebfeafa0a4a9e214a71de17afdb6b26263a32e485579686eafa384807afb294a08d16396926c24177e7bc2ffb2275cc1fbfdf676b1b7cb487b86c315e579dc83e5a2722d17de7dfb3ea19989f4885b7ea9f0ef45c2fa58fa6e9c2eeb82324e2493b626a51f10260e04f43baacc952f5c1097d39e4b891664ac825c34855966c21153f0e5d0965ec163251026801e6c5d05fd6e00a1611760ade0b9f204044c17a82a9629123dca42a24f56fc2c997908e998b4355982d43855a20121f902b85c5c8a6319ed10240b4ba58d370f5928ace61a500ed0e858e6c853370f3e871a6482db39f47c16947510ccccee729e9d9bf9d29d745487b5f650aaffc12f3b57b0542cab3c3bd1bdf765b0dc233dfe7b0191bc33e63891e85a70fb5bb1f4a6107a4095dc44e96519ca4e36b62fa214ad13528206547f4ac0125eb3d44253538772dd830673aad57d05c250f5d23b60c37009bc999aeb103110f604ee4bf5631753faf8f9120c2a555916028dac6b3bfe5e0320ed47a7a36d5330c53c092c4d15541d0aede3688da2bf0254566bddbd745b9975b34e2894543f68fe32313c5cd15749d4014759e0f9a3346551df39563fbeef7dd5bd08417573fb51fcceb27b07e93f45603d371abfa0c483013be9dabe2c3ad2b4ec7b24d77b760deedf14f863c17c370d0b9536459120cb7cf01e73ef23389835fd6c8dd5adda8aaeff658a78ee6a8195c0187e8c8d3a295da9d287831daaa4dc3770c6772fbda02d42e9516f05ebfc8e1aaf95a7ae1b47587364bc2d434b52c3abb634330258173b886c90b6ca8ca55db302074aa3c755fad2841229884071c76442ee5bb13f4984ab427f36b167ccefcf5a0fac4e3f0dad66f5ebdd6507b90814b121ca92f3a6cbe357d6d6ee6881c323e1e59f6b1c9b07ec9d1d44e141b237bc785665615155b326de4b2eea512a7b82fbc38af6174655f8d1aed1dc1808aed9e5662bde9885e147e48cbaa7c3bec083ecf0fb2abbf795bf5304f5b944a9a13b0a9461e92beed830a34bfc8c4c6164901d214425248550627634f4624685fad0026d9912e77698cde6b0e1c69c67497fb23ed8d6361c80898a734629e505096b3e75178288364f645ef7d6db9345a8cc4c2a13350689604d98df0bfee05e3e3db7807a4bdf5ad242188e5501e4ab19545fcc4fce56607fb8909f64ce14bc2b2ed54689f728e9abb9394a2898d018a904fe0ee5501e890873dba7283318b487e5b26b7e5aec89711f7fb866d23c96a04089df45e13871a1618ce71c1b0ddda1288e0a3f9d20702e4898b3e1a4bc8f7cb0cbdc8f0d15231f5e76b1c2fe106755637ad1e2eb49df606e51f67bde3fc87ce4a434a4cc7817f8ae892f53cfce790e2c0f6087a3830e826f9816576d51d4a9be5c504a417d12dbeaa3e727c90a17d27ff23ed1bb01fb960313f5f9e70d134eb922b66f15eaa1d85e8e85d84acce74f9bf7688c02fd844a098c0c6c7c7c7beac7907135eb4f967ddfccccf8455a954b761e2b53ee4799725cf20d082111c4f2ded1b7ab424bdf9c3df3e0e785601ba728f69df4eb7ad9337f737cdeec5f7e87ff3777dd121934c6f5248b566b0852d52c5a53121ad5e51cae82278dbafb2978d2e831cb64d1c885df59b47f308bc6585bdc4cdad6de5850d87f7436ab3d8e66377f8d78e075e4ad61f0fa2bc600f0ae85ac02c6a22e5ff790f9a04e3b449400bfc311e64786e7b37f1e0b67b57307c426be158aa83d889cbb50400b9102690184d7b59e39d8c7de552e02d93d3a6b2d707cd1b76b50542e4046aea62ef99109f08a7b132fed6d45d8d127cb743c829729caa6c5de8ef7c26ca30266f41d6428c1c15d038156337c1e37179632621171256d5d8f0c7d32163f4ac060f0db1db3c83450a4c59b0a7b9d7eb799d394e76557230ec7645e434472f941cfc9270c43d10ef501f7816e333307d9ebe16da2143a8a40b249258ac8bdcbc853a2ef14bc53d47616f7c688265c69ee1a5184fb839e352ad8e5bfd7769469f8b4391c0cb6cfeb0f9ac31b74ef82678dfefe5973786aea0b7ba36dddceea839eb72dce8c06446f20eb7bb295f6d2327091ee52f0d1b9319635d7681f00d1f0fc79733a1276f9a039ed44823505ef198bce7cd69c2e272af3d66c7276ece05e247b478b14fc98ac6f3d2acac227b2cad51b24fde744b58bb3c6cbb2d9de024f15d63e10a84563f459f3746c19117e5b6dcb47203a2d787fef21e50a493b662d6a87b55ea76b1ddb8ef202a2ad8e2ffb96f10ac809610ed8da0eb5de3f6b6e07dd0093f4791fbf9f91b6babf9ca42dd63aa7f63459b043391edf4f1b975577e1ac07474bd1cf1f34677c4019d9128fcc4d9727bef0da0f4f9eaecbf7bde943843000dcbba97ab33520989a2bcbecef101e5b698720e76db564ff19eac124713f33fb1f7e163a4ab0743b7a88ce43f273e1b9206e30a1592270e3cb0b1bc45236b170893737c485254d0be755e6de9eda1908cf0642c6e12c53227043b24c4f4bf50459a6a7503e464223207e17084ff0ce0029b0dca5df71ebceb30d282a0f0e4febb6e82ab73fd50e10ae6adb99d4def58cf66e6eb887a7b508648d3b6bb263f52b9cc15c50dac26cb2c5222fcd93bd767eced6edb33599093d253046abe6d83dcb3d4d728fa3695b1e4d1b13dd58b475c395e1ff1afa5dbb3512da1afc7f746eab23d3bd473f4ffb27fb41d7f44015fa6b71a08ff79ba787b63c95f62d6db2120666773c41d7b5e5a9a81b13b1db1b4c07d678ba6aa28cdba5755e8ae4afbd9c49fa09b294f8fd1d1f012d25b54fd11ca148865b11b16bf29ef11e81057318642f477b0d421e8d8d357993088e37cc9d7b908d6c0082f6a0ebdd7e6f3d082da32d58d3a63f5feb4bb70dc2340bb437f7d0f3eb67e32cbb8fa5ec2c82c42b49344ecbbeafda2bebbe14ca828eb77cb8f84a4246f0f2f5ea2d922855a2395a32649c7c81c48c6f6f2bc78cebd24de3a65e39662c5e23667c7b5b1033beb20470f49c2582c6f185df41e37f3a689cacae7251e36f09e0ff590960fa3d7f0509e00f8f2ab3e8f420a29ca1eaa6df0ff5bbf759e6244a35817f3f285283db60d206a2763f53fa37401ee907bd4f746f36f53a6a576ffe2a6515fe4d1165ea593354b3f13b56f4cdcc1834d45637c24d8f23d9a35e4ef638a6ee8aa2e126c560f079e336999bdb8f922f3ec3bdd3b52a182fa480cca55e4fa8c95f034b6a2f9fc777be23053725c60cf59ba660fbbc359544a43e68dc5ea9e8756a1f89a4b6e4a8ce299142ec5b86bb98d5006b4f45cb7854c764cca9e72818c792f256afc799a10556bbc9ed3b591f49442dc8cd730ae337b64c54371030d84e6e1d0964754fb9b58bd734e04941f20ed1f85a06ac27398cef174583a2a8e3062245700e51351ab03f2bd4ffc715a595697caad41846edbced19e8ef234a4f0547fc142b4459f69a7e7294d7ad6576913c15be27634cf3ac317f7f26a4d25e0cb582cb12fb4a2ccfff07f6e204e7b9e48f5d9c392951d345654672b6113bc3a16209b45476a738b391d40824fddff4997841564d118d89a56a8a0ee3e9c88bf72a2adbc4ab21c25286903d401859f26cf9e7e6d6dff1ea8b3ed416a53220235b0a0eee3dffddbf6bdd907682e609b5c3a1b68efb1344599b9c5d4ee3d2a3f3a7853236629cc9889ea3f2be4dfcac445e70b4d677201b04352c6a87dc1f3203cd2d4439018f8f24f5b3e72f1bfffc8136c157c818b1ecf0f8da247bf499fec727678ce8f94c32bd5da5be535b1aba46bd7f69263667d2377406400d704b3bfc32855d327fe06c88be9bb29118994c6dda7eea4d1ecebd96ac4f1ffafb11d818be8c18f120ab99f4f304758ea799d945355daa82e4ec8e3644bac30f3f6be27aa44ccd1dffdd188e3737529928c86aec20a3e174ba81bb0e023794894c7fd15e94be77ebce736a5a03d5e86fdcadd5d15ea84cd48b65049b796774d35fde9d9c8ef753557006a225aee7e6e806c60ca40c9f72b5293270026ce646fd6666ea827dde79709dab0440bfbfc7b26337b60175f5afc153c8ea577709b54b73a3215946035160f76ac91ec9a9ed3a965fe728db8724fc49bc80ecd3d63a006e0d8c4c4bce655521d267b717cf05748fe277019c064bcb94a1fe04bfbbdbff827f3233e5687e108925fc5e49ff48762ad3070fecd371743e813ce57156d3a3da3d906478009b0532535aeb791aac1e5b6e9ce9ec86ab031a6fd305bfe6e8860d7dba3a1d66b56e00d9db89d4fd2fd83b908d7aec682f73b37f40cf670eec7e5bd8aabe7cb45715b26012ca5aa1fd03b52de9c2c8d404671d402d70f0d872b1dfeeecd4cedbfaf4e1d9b060b07011a20cdf23b377d3f577f41c2e57d33c40b2b7f67d8e1325c5f5413fd357661d7c6a31651345a723efe6861e3ae78a6c83798e97540c37f1c95456ccb6801f84eae7db24a5893d86114af547de9991d4c38fdec3ff71764d380783c061f07f4ce971e8240ca8657806d425b082368f0ed46d8ee5a49d25dfae4fa1bbe8f7cb40775df04350fc80c46abf028fc7bbec7e692f329157c9fb8910dd79de8f49f27e65c80ba4d65ad666e4cfb12ce2e65df6ded2ae0d9848325c2b4ffe9e7d16c47e0b3603c8927e0dee08c4f5c0dcc3b37b4e1689f037309e52eb9fc77b70e97b685e5663af86f530f24652f3cce56860733af4e179d536926aaad45f66ff187c0ce8ba3ce3f86dae5631cf6fb4020eb77918c9ede13d3e599bac580b87c51cf6c8ae62a1ea1ee40b29d682c38a9a89ed8c0ec97be9228e25b817e1e7014e06b5a5ede646e337bdf792eb923605af0748189342c4d23f67f669521f4bda8aef03885b9a17af46eff769f66ff21d92471d6ee8dfd16ce08d144b2cb4d955d01aa59e1dcbbe24fb3eafbe37ee777c0fd8634bc9d7e071447359bb47fb5999ef29c87f5ddb356a5ce3ff47f35b57823d62bac6f2ef34f2a8f0fef9e7e3c8b3a5aaa152f6816de8c25c69229423dea3e33dd26a895b7b0de334f5996dbd590a7ac4b589529f32f6d6a61bd84653cae676f373a4cbc86bbc5dbe356184677331ba9deec2913c1f380ce7933acf27e0a1da2ef17e7c3c6b7a326e67158de53eb05af53f2a31f477605e463fa635ed08b2c4139897c8e7d54acbc0b7fc1476257e4e54b9a938fbbe8fed8702bc0a590fb9b54df5f56deb96c4e8f09e9dcc03be24b7ff0e09f7f57e6baf477909f7957874d7511c23b777a4731f3dc83d55f13562458acd1ff131c24b3e86bdd1f7b3b51e96b4cb5379df1c729fca35ab3e85787e5bdf854b7daf9817593852f3c0f229a6e46fcb37f53337c6b10f5d3177979f97c8960cd587242f96698b5f3185ab254a554d55c9efb1dadb64d557a27c4884cd2b6893b39f93f1a3ce85783ffb04ff2de19b45555483a3b31e55f3dfbc17661f2b54aa637f2df92e3ecfaeaf6ef406d9cce41c1a55fa5e2a6756e57b787d56f411e335f309bedb61a2e82b4bd243162f6db69dacece5352534c75210ada912d752efa39c74790071f141cc4f75e1feab5ea08596318d626c29fede3ab7bd7f5e5e32818f0773fb39d855aae7a0bf12b300d5859b8a151db7cd9f62fd67c58a0e49a85f85050875f7535880a2c72c51d0115ff85dd0f14f1774d0cbab5c494764cabd9eff2819d0855416d5c71ccd3d93bed07b29194a91b7336947b97cea2b365fcbd0dfaff17148bb8c274b997d5192a387bcab75affe9876603e8c3c0c59436e7bbfd084a4ccdc6cdf8a5389a5dd930c54e4fc6c0021d4ebd1aa41ba02a0329cd2908a6943fabe1866906e8b116ea2527b0ddb006987e6666ee8679659455284d4fc80d28438b5f767dc8f5c1a2c0d99da80982148826052092465a3073c3860a5f006b9b71f15183bd222b0ccd1a57146dff903e953b62bb91e884e0dd2feea8f514d3f47a13342acc37c97570f9164d6475450df527fe852b08254d6a322ee1f39b0e77c18ac21da463770fc72610a376a83ac17d2b6671bc16138565ffb2d15e05481732ff8fd563d25113298dcf96f9bb36af19c5d2f82b9e1be005c7d8cc904d4d6626f8f39d0c7fcd970700dd18733290f3564401f93f622481af5ff32e3d05fbe3585fd503c0e1b79e120228ec66866ca114ce1dd6b16df93ac59a57dc267f7c5b5389b8cfce1eaa1aaecc6ce323501b5b17a0d5c2c6b40ae1b7ad7dc0b2fccabcde0f71c20d401fad723760807a6f8ded0e5792e05a7646fdc6f9d643e9d52f3a7a5968609e5a0ce9b5468074af35eacb11c9548418aa7259fd0dc41308ca9674b8dddf3186457628828deffe4e8dce8f40f6a3b1a1f8098aa185eee84401cd20d6686d6503bfa19c24828c485aeb1b6ce06d289d179d08bdf817c0fd054f5fe217c6ac5f7f710f94c4b16e6cad403383e88b4466d010c5204a8eb02f66f42e78bca9314449b0e14ecedd1b4beef4ffa88a48510b6c4242d637903d23ab331a3ed96bcb53720a3d25e31ca5ab2f65d1ee6f01e68ddf2754b5f331fcbfe7c8c427f0717ad710897ae7ea86d80e33504b017004e4860c54e4d6cc6efe2cc24ace0c2f38600318da554f4031a27536cd2f3980f27e92e66d28ea6cea7d7e387a4b67038859ee348a054c53093943dcd8395b042924c88463d5ee7f9f37211cca4dd1effdd4fce5898e783dfb375b30e6318094deb2bdcffe4a3340f33e9157c9234747513bd8b29deebec5a1724aa5eba5274961b52742ecd0dcd052829d8059ad15ece5b8ba57a2ffc5095fe21ea1ba434ef9a2a868a26bf5ba1b42d03a6c20e77f2e030ccf18acae02b5c3f4570acf2d7773506c4261ba663854e9982f065e4463873c5ba7ff7dc2067c1d6ca97c5e0f2fbe87acb18b1c58ae3f066633216755e1894844c69fbb8e85ee89d7043a218fa81fb98bf8611cae6a6ee79639b164116d565e3c90e652c6107507b6d39c7670440a0618f74c27a85be60e21b9f1bba4d0b1fd3e72e2581f4a5858f9340d161ebcef7cf9542b7f45748e8566cdc560cddded46f6e6eeab5aaa15ba9768dd06dd4ddcf08dde2c7bc1cba4d2efc0eddfed3a15b7a79950bddba26a0e7b4e07f8ec77d530a89c4405f881bc2e797410066913cc7841bb1f15f47492ac918ec3d3e47a18baa1c49555c95733fd99cf3543f1344688a7b1eeed7b9a3c642442ab3c398952141e6a2e31f5799abfdc75aba9a90c5be207873a30ee11b4073ddf66a77fc76b36e64be7d0aa5213647e608c2a7b98c2bae668c5821a6e98aefb8f27d723a3afcd059caa560ccc985ade8217205d1bf80f0b48eaa220a99502813d5e8982a54abadacb1789e49ed0371bb705b9eda1e2c6706766bdbed3171b954a5b95695079a13d873955b2f42aa45660a7257d1cf300feb8f4ca5ed1ababf9f7f1e11a173d56563e880db072ecd184ce9f60118b3680ec178bcb26ac2f9b07d91a64008d5d5f06ebe02d22e574d5525a49552d1cd5768c4f3d6573d678d94ce43cb1895085d41c8aa19aacae0c5969a804a5d946330c82235dea1225c9a0ffa23107df93db92aca18583580d3f5792cd77a9387539fa07926ec10064f89b4e533e70e234443adb5f47c4fa1f7d315b7d554ee872ba436e8cdb196485ccd987521a29f6f7b92b8981bf548c1a903212d7987cef956f73433dc405b378f84856fd86a1217f613959faaf80d6987a1fe53f85911eb51bff9d9141a151d06b1295dc36188bacb7118c49bab823da2e72c01f6882ffcf618fe658fa182ab10493371b693310034c97642221e9c6b27867e00491eb4f528689ba2b64294295b40fb409af297146187c315b5ed5f907ae2dd378574a04d2c6380cc0c46017c16c992fa3e2b9be4483a1085bca87ecec46102e1e98c11b83553530f9ccdeaa049baa0b6baaf2014cf049be3767a7e9d651e4d40107c2665dd97e4e8ad683210c4cdc5ac444f6aafac8b66031234f531015753f505c84087b684a8e709d03aa1f19e5c762bca1599a54c62c828d1267b08e44156288498b4d5c3e41f7b9cb1dacf249d64752a99d14e96dc2e2380ef8c334560158abd4966b54c91009175ca16dfb3e49cf8a6737b67e38c3c9021906b52f32c870e01b3b232fa2645f0a5b6bc5576dcf0f36c8b48bebe087a87b83765104fb4b44dee5d51e0e752d97d75d9802c14e5e236ce9fe2e2f8989c51d15f21dba9b5ee5ebaad86e61a7af83c6e74209b9b76f182e62f3267157d958415fea4abc446f6b0c9aea28c4fe65a1ac5923b0f50863114f1dfd5f80cc1193fdfae792977d8a549ab960f04dd03a4677e2c6a9c5923b6d2acc119126711a9f5911ebf04b1c4cb8ae1bffb31291ddc7bd9e8c3be1987a398489efcf9fc0ef407391fca16e264dc3c6b6b63b4ca55e4210c2842dd01e27107676e4616a2e87e18f5f37aae98c93fbaa60661a520234de101092c22c80a30da80200b42f5c7505951efb5bb5595ee3622096a44289eb820f8816b5765497dde422e919973a54926f058b20a6bf819c9a2c29e40dece6a9ae8aca7bc22a2ea99f5a2f6883465c5b688cc2323eb5f946d8f09ae2b7e8f103c577b362a3c5d7d5c9a07f521f213aab70bf37750bdbf978aba8abf8b4843749cd6b1ee2b8fb18c09fdb9738159bcc4414714a22478f6180a9389ac33bdb8d88c7c20d3cf19b332fb9e63aaff0144268502e65708b0ee4d7f72e776b0b742716d77744067f09f21fdb9989682b06daf16ef1987f946de10d92d1aa1012470404e381cab029013aa7e4c4ed85497232027f47be7fa63993e65cf48e627e31f653f4cc4088310a4170c7a3a0f61427dd0f54bfebbcd8a5250e16e4a68e3e147a97650bf90efc02f14cca26072e4ce09593cb69d7c9c5a43a42708dd82089c77071dfd5dc7bf6fac982899160ba19323aaa748d611ea9cdbe61408ec6902e92b3c274df4ca6d37be26b8c2f38e12f27120fa9d6a714af3aacf85c9bcb9cf84fe5efdfd15a417125f6c9999d3be2c0071a805049641d3b795f6611eaa3f58e953ca8fc2e441f552fd8258612fd04f96d286f4ed4103bfc1680b6a4b3df5fddb537f7c7b1af877af83c9cb6910c5064bdd97fb0eaaa55a08d22d6f139b0371260d02a7d6e722642da3ceb639199f4b71ba9ea481dfc84e797288838ada43527924050668fab50e24a7072084b3d7d6d6590b2f5d81b429d37bdb7fba61be2229fb796beab59094e8edfed10aa5344d95f7ae0467dd3ecf23f2b2ed90e737653f549a8ff9f7e2b389492444c56c768e34cdfaf9656c971cb1507f4248ecb14d44c8be96fcfd8a679f15c72072f6591aa9988949c43fbf3dd5f8f145e6c18b33dffb2f9bff9bbb6e896c63fef218a1f8b3deac88506c08a258ab2a17284957292e8fbafb2908c5e8314b2014e30bbff38dff60be31bfb4b829472abc2c2e2c05a30ebfd38e9cb46323704339411b2d8b538fd4b6fd5eee1e462a2b095b3861337a771774fe72e0762ae4aead83c056b4b3eadf1db2a1804f52df4de6e2a6ff17aaef2284eeca322c302939639b2b9cb88a0ecce76b1226cf16a35ec77ce45dbc0ee8dfbd4f8be237b884ea32e2ce2bd0a0a8926ac3f7ac3f1687cb80ef4f6387cb783c669b6c0aac0efa44146a317a8e37a72e711fe3b998193b56ca2bb32e787b6135ed1628660617118aba8dc6796e68c195de0d689e04f6264f3cc04e3d4666bcab2cb6f61ab418b4a3d3928f960fc8dda9e776ba015a2ff87bf97ba6539f5308471a0d26a1c11b529fabd4fa09f09875c426796ecbd062bd98d2dc775f650eac61dcf5332af6025281337f5f42fb101adb87927b5153b0cc3e68021c5db876d9f787f9fd08b58fc31fc1734783f1cda4e1c839fdd1ae50a2d65cce174aae27ce50eda656d919aaddd6eab7959da1c6359ca1a8bb9fe30ca1c72ce30c910bbf9da17fd9194ad656296f080538ffe7eab4de4c45950964debf1e66e62c07489a6d107dc4d2011a8d0cab323c932dd180926e08340db35a57b40d00e3a9fb3e3bd1bf4bd5f9a4da10513931d4f8d020441ae09452c7dcf449c269379f5cb0f4027901c907566071a8a483a370c258ca20044a9a9e01c143c11f2eb9de4d95c418b79fdc935e6ae60037f6ba2d65de1fa95da3ad50d236d0cd80dad98e7ac6b4927dfebbd8821512c5db0bde311f984bd41c018c979e0fe9844472ea3b21b132e331a5fb462c3156c0bb0040c34842b383e2af8e0f8a335a406acd2e007f5273616668ab610d5b81d5fa4eb30acb9632f2e689d59d6dff520461059e87ab4c8b95a7d623a0ca40e3a5d2f76fdd01ed0c890a407d5a00b59a36f45b6aace76677ffd8721f701b307fa944583d4e980f43674bef4519d01c9f1690a16845df1f030b5273e90f2a2a642314172347704e915acd91b4d8da0a2415ebc9de94dde73772be56f27e4a7b652f036a5f49ed7b7ecacbe1eeab3d09d548e5c01b15eaac485467af2a494d6c125188a99020911ab11083479428b7a693850c006f3af2904bc87c08b5cd95541b5e73aa04a03e972b7c185d9e43b85fa528771835b650273bcb4674184a1d4c805430e8e96ce01913e046225965afa7d8a699002a0e68ab08f0954d36c2ffb3d4434cf6ee32a02aceb85d06dd60aa1a7ea105732d66e9621643762293000cd2409049e1b510c19a3a6b3d88d62b3c73729e705481f2895c7e029795b8cd2bc1b42225187a2c542538ab9d0114a04096e0c532bb012b819b4fdcb213b6701d61ea26eb0cafa52faf60d9cf03a65f06f78d3652545430dbbee4f10b1bb27b0a17a02be37f41c146dee76a76df45df55998e2ab19f18145957a299a2c7b0ecdccd808b509dfa0929ccb6d8809ad479580016227b0880af74b83fe6c248296f7018f6b9eba03308caee5f080cd3199c6646bda92e0988827e3e79eb843250af4276c0c3fc099f41f5f4057d6916adf3e887867cc28a8ab21501f745202a866d7731a3f846f052debf498d879c8ba1507d53e686beb33a7d5f6d51f6ef1bed79aa08f1c7546af3380bbe48a6f28ba94ac4300344615f25cc4d7d2106fdd46bb56a71eec64f49147ede548c738bcde655403fa8bb9f11e7c68f7939ce9d5cf81de7fe97e3dcd4e22a15e8c6e93b8418e64121547080081422de60de0bebc1b097b8925a21072ae564fbf2d9ed043b38486ca35def995145c5937f77ee2fe5d3737c20a14323ae109ed60270d877b6e436d416387e5ad09fa8751a823154a03a443e3beb7e2e284757a5e4034916ad39d044157a13e02056a14a1caa565a33336a13914a750a201335ad81aaf436f173bdf6efef82a730ae14b951efbd74a5486e4cada3bd19ed6da379b06b60840d024680161db469038269e865aa5f927e3b61f3d54990efb97b773789bec2f304053227c938055170281a9ffb32638379ebc1a01407beeacd314ffc70ac8683c92c190f56e047f1ced4c1bc9ff9c249e507bc6f7b92772607f33b82dfdee9b70306eb61aab485793a587531e064b53057744b3cd852235097bcf70d8135029591e1d9428a3181406872c99911cc533a084a1b35cb6b05ce537007eafdaf3c75d30e51f531306c98561057838cd51f535d4d07f34af62d33e7a00a6f611b6dc15907f5abf57bad2d5ca50d7cb5e1dcf4a07a6701557bee5a8f611aeab2f1006d4f70db59438f0adcbc6d5fa91054a59912b8fb20e73d4473e4eeb5bff4e8f711cf5bfc6efcd861645418c446f8a5a4852f1fedf560373706bf234d8c0604420ec828df8c5e1ec7b1d64d3e90ce37c8b3c9b5b2f3b990133dde3729aee04f25f28a8ded9d33df54b1b593eb63535b94ead54ced9b9bfa4ff167757cfded55f0f5a8bb9f0229891eb304a424bef0dbd4fe974ded646d95b2b411d5e737f5efff0cf5efc0ae5981da49a826a9544b4a910dda7128ce9708ea2267ad4ac559437ab09896f72225af518e9297dd27f5c7b033005eb283756e4ced103c047e9a07bf1f807ce0efd4d314c4bcf0a5f7920e43c673294a5ba7610aa9b9f1c7286d4ba80b526bbd08309fbff7dbc396d812541ccf0d05f01cfd21129e9f82b714b2c29959de96ec7c47c2d1becab6c4af6369f12cf0983e76bcd1cf6e4b3e4634c91c0ff16fa66525d6dcae8225b7cb58714d41aa68c5357ed61b6fb0e26eae62c5a1ee7e8a15173d66092b2ebef0db8afb87adb85d690b6ed3ff2e8fc4e591fdbcae21004a294b0580b65e58ddd2cadd977bfa0070b2448c01800950b2153cdf0b086406162e8ab9ae714cc1577f8c0c6d954fce51fda54f18f6b8fc3d6c676f641f4300bcea0c575d8a11a8729b9ad916e7a68c34d5980090c2e76c2f7920102e20890ffa2a047ff14ac72eb066e20f7ce76e35939a22e83a3f2d8598f1a6db6adc432ec4edf481a9eb817f0f26e824f90080ac95e4521ca5bdb22076188a3bcbb08427284f0cef62cf4253f41300ee489bbda51e502047e6a7009c5d16dcc27cb7d86acd83e21800185c9ec903afe51986426d89c6b745cfd3e02ddfa7004165198ef8cf530cbcc5ac9a99f16402118ca6e82ac04a3985b24a0063afe27d91c158c466866c87a0a96b29530ad84cc5eaabcd052855ff8f834b2a2ff6bf405f1383ef4f76473f7381f7d94f796fa6c88339bbc660699983330f088e3c97727d6202ba7860a88b5a7c25ce84026d3e7a3cbc674317be996f4a31df78fea69427e7f9d958bc2488d5bcb8c6add4a837abc25e24e12ae21a51773fc38bc38f79d98b4b2efcf6e2fe492fcef34bc4e0ddaded7f26c2655ad633f36cd3dbcf4c5db0430a0db21617cf2d3152eabf7ff106f7778000f919c737af0945cda270a8ef3024d6f68eb4383a2dbaaf11fac401eecac98b37e874037bb2239642fa5ef7ea0f5d82937f7174cd41908d93d33b3fed81b57ca6c79583b54699efbb83667643bbd6a54fc6ec77b3127331ef5de21db67796d91530daa3ed6cba47679345d044b0704cd471095ebb991bf5ab406aed7544e0928e313780ec2057e6564cb482d123d41832491d36fad932bb888c435d367447d243fb0348670019916a2b89ad8b719b802282f5220d8eb629ef2c53cbfd9d22a2c1d75aa0cc1d237c381e3d635da50879a212e550cd3d6b869ce604f3d3959a2194af4e61bdd5dca3b31eb1f9f059ef1c88546a831a948ea9f977476bc76ca37914b7919444a6efb1704de0e46f02b22f70a18df54074365d285fd8a7bedfe906c89359ebab12e355955f3289cc749c7d7f7207a8acbe2db95b9bcd25590a22fda5159c5f7ebbcfbf132ab6420b31736d6c258af5aa56e2cf26507a54b612c5ab588962fdb3acc4e8314b5889f185df56e23f682566d615d7522456d4371562392ac488e5fb8fd220466a116529108bada18b27fbd23507822d89e85d8d6a3a14b4ed2d53e59fec95446e072228e7d8868ef0bc168a7d7703569c90a3ee743f579a47479a7ab3b19cf475599eea2c79a66e358a3b564cd7c42a442b64a92dec0d60c0ef5e00b533429648fbf06c8acd479aa1fd9bde2c4d6f86e26ff17d526de43c91cfa3ea8cf6c9bf92a6f3ea965dc22b55c2b44b2e26b65dbd26542d7cbb6d346b4da9b26d57bb866d1775f773e475a3e72c130224177e1b77ffac7197acac8bd6dd37b55b8ada6d465b8004c9011930ace1823361f7af6738b1a97efb3343db5863d4e774f504b202b0b5190c04c8d2609c285004fcb810f7633c1bc2104bc442b47244bd99ac7b44b97398a038592643566c459e5d85832de568875c8ce594c29d8ee23e471586794bb404fe34ee7bca32db307442aac46db096a4653e788f1dac25d8aa6fa19a6866349696f1bab38c115445a528b210eeb83308c8ef63cc6a62edf99967f42ab445570e8596093a5c10a76f1eac7510da636f43ac0eb867b7959ecb88666c13f7233bd69efa202e9cf5cec3c4cb99b1e359482c8d1376263433df636ba7e5332dd4f7d13aa079d5fd684a87f45e919e6fefb4b829af68d9b84f79351ccb3b26902ea1833c4bbcb858371508dc93f9173fdb245a5fa822309c199600d58c96129c11f50d4dda9cd913b2fbd37bbd2c7a9dc0efd456b27f50f72ed2d0c5f512503710ac55401949e26966341a50c5fa886a1a68af2d489e8dab17ccaec4259f61ab493cded4f7727bfffbbdf403f2cc42a0864284d105de7942109d9f9f28d717026558e2a163ef0f55a8bbe7e1583de3fc109923915758c3f457e60274c1820cb2295eeff4efc89e52bd06a012754935cf6ddd5ececf696456ae7e807a7ff1bbfc7ba8b0fa601b3ae72ab43b29a681175cadea65ed9dc7f19d6f75ba0b6b83ed48b2b7a3b92ca30a625baad3efe418512416db1eceba59531567dff759fbff75f76ebe0e73ce66246bbb0c997f44d9eb03e569b0e819786d8ebb5dfc7b7ee48bb52f42244e5a1cedda2050917d8a6ab1f891a4348d176a9345eccf8ab0f1a8f548de792aecf578ffc4747e5474cbabbfb426b047f6288d5cf83d600cb066f60efe4ea13fc79639089c8d15582daca53896c3f877f7fcc81e2f5ac6dc8733f39db7866c23139165ceb986681bddc0f18b73bd643ed2fa94aa226ea1e6ab4fdbb048dbbc1dce8d07a239de54a9f1e3cd97723e05d2743fabefdd8b696425a6e2456b99bd27a7f7ffacddc864b37873c41c23ca93ef62eac2ecfa65a3cb39284236da1aaf3b36c2fb4df49ddcef216dcf69a5efe84a539819a74adf61530ee651991c2d660e529ce3abc27c31fb59c464d13ba8a4bd0bfb1c0775cd43a056a105a510d18d91b30e96b80e9689728ed1da81767495f60e8b7570fb906b0bef6f795f31b183a73560ed986ed55677e5acdb6b820c555bb97bbdfb4c2e8a07f0f7c93267f3c5b84095f8406819a836347bfe277383dbf78a59054343e7abe3f310e55a3833072f146a9bc42398cf97b539cbfa1c185d9c9b574ce4329f8ab6700da6a92fa798fa12e6827e563b41e0b6ea55fb907f963cdd25697f0db896ecbdd354c4c42e8dd61ad3d661d07a923d91a6dbed87a5f6d144b0aae09ab27b2b2f8682df6df6f9cba1c9bf3c5e685736a3b44b6793c466a3a25c50a35993ead5b55385abc80545ddfd14a450f498259249f185dfc9a47f3599b42b9548daf4bf21428510219c5439bf5507267b4f7e705bf5e5bd656a2fb6346aaa6b37c806b5ab06859dc87864389e5170075397bd20231e819b175b276c4441e6d859f4f25a267ce88e6c2b272800584080f1629035096044416996e35010c4c805917d28fd2489bacf56a14cc140cacf8f8c319c2e39ccc2c218462a057d89343002d0f5a80fc7880807d6ce2e45671739f6e9d2c74c5f6963831d7418edadf56bf0dcca90ff7464a2bb911dd35becb027f7d8e0b271d6dc62f1ec1770b71739bfd8f1e01971dcef250988eadfc54e5be5ef4dc04933fb95bf77c1982d68af0d0435baa3407ff515d398e51ab4f8f70c5d15e26c9002a4d4efd9eb7f88cf22e6fa2f725e0a0209b1f3cb2c898d121e844e96a729920f0cd4dc2dcc33bab43d4ee66da0bc3d49f42142abb0812084a8cc1505597799e456bc37311d2c662003cd2fbd647976e3e7b3f41ad8fe1d0ece0cca7faf16bf9fa95dd3167607be8bf6026a1f85e2a6069d582b55e2aeb61af1bcebad60ef14176eebceefdfabc7fefdc3b17f3ff3fa93e94dff1e820985f7e5d11f143bc3644c59f39aa7d3e2473a2df179ad5808dcf25d5e5ca2bc783bf79edb2f2ffb122e61fa52e2144a8daad5230de167bd56b57a446c5e0561887afb292e61f494255cc2f8c26f97f01f7409d38b8aeb14e63689b4f33108e7a62c10c6cb7947139c4effa617369796523fcc37031060dcba1bf560af75a127354ef6183699a6e0d4205a2adf0dc6f5536ff90006e7d96d35ffeb84f4dfef0eb30d70e6bf06bdb5b8b5fdc67f9d50051e19c132dc10328d10b59d2b0fe7a7fbd1deaa69edf9a6fbd01307c1a8a6edc7fae0f778bd7fd28df6622c88c7c9c3e8d40b9b6dfbe1e5f5c91cecedda561cb767d2f372d0b58cc5ca097767dd78f59fa6ddc3932eef9eefe5fa00b263b5eed1a90d8ece3ad8cc410c68dd062ef3866d344507f8455acdf3acd6dd3a9dd1c7b1ebc10beb3ccfdd921b667229d9301b37b75537ccdaad54abaab92d36ebd7d830516fdfb161fe2cbf61a2a72cb361920bbf37cc7f74c34c1655f186998a92b5da0f1361303574b9ad2a988273f98aac2b5501055ea7a9aef5030581ce7bbbf7af10095aa8cae0656676cf88e068a30b962fd455ffeec8be16c3bb36d6d632dd935deb42e81f52fa02221e43e4f49a30bf1757966941ea798fc52976aa121ce6a6b69d19a7bd230d16b6326de62313900e82940c82b944245ce6e0c532746807933c755fdc8e7672ce2fc79e3458a10d5cd2025b0924c76f1c2c7374b437f21e4a97ed9a76464ab32d710b900a47b87bed2da7873ea4c9a486c0bc266c2c9d5a4ad9139e1f68587fab40d900705044e9a09fe78a0e11b4389a7285c30ada3ecee07044f4c2ed133ea4e0f73e8ca1abe81b671d08cf004596168bfed9137ae3ddc95eca753d70424b1f8c07d3e6d19eba4fc6543bcc3b5b79b6d2ce1371519fd5acc964d99e4e3b72a8afc4e964a5f9733d988f6a0b6bb66a0eed7be7f7682a4acfbad6e96f3c80be85766d70b66bddad2505074407b16e1e9e213d6ae6c60860263b2b824d4ed1cf38b58d7e265efafde94820001f961a82d3aaffbc39943cd8924bc9c1566f8837154fb69b5b49ac553ed8aec2fc1ff5f63db546e58f36f4984289a32dbef0fb68fb478fb66459151f6d745207fedeed00b3898af81d81fdc64969514130500be280473670bc91eb28c1d35901f2132a6e006df69b20c05c69b175d3a82e46d03c5589446f71bc2035aacbee996e3833ef40bb696b49f5fddc84e0483f610c52b610b8db5a9b5554d7aa001fa2b884a3139e770e6cde4ab07ec6cc25f0fb9ef1bae9999070e86695013288e55b9f5509c1ef0bb02fa99e6daef636a0645bf2de1a8b3b2b1411034a66bc4845c50aaa979c356618371a470e92e79a6c48e8c800647526f875eb1a27ea5d341608f907098f09202a0181fbbae9196dd1556ed173f67df9bf10489d1b5603a3d611ab4b6a4c106af78e3c6fa449954f92e1c05fb0565b1a62d4e9ad060bdbbf438941401ec3dc612477e09ded72cfc7e0b1a45153f6ba2df58cc834c2fdaacd9540b062658401a0d9022723845ef83e6a72601928882c58a61aaaca683f5b0317e98e62da42ac47afaa9ff0a15aeb26ccd9f3dcdc064ffe5de8acf5fd53e4af6e19f3009ecbd394f679ae748f33b3bb62f58fc3fb08d5088c7b72839a71620d9275780f398c4049a485c4e5a11201c688f9bda1c26a2b57154094493ef4ddb8a6bcec9983e5cca87b564bf481d3741ecafbe7165e9b8a88f99907c2b3f10ae8b4c89c3507dbe7f5f446bdef869621fc24a83176bbf2049ee50fbd0fa8c05b5bef7b17348a93defb0e3373b60754f13cc4efc0745f60ff237b5abcdeb3fb29f9dc67f95381f722587de41a1953c2ec7ff91a8958b110bfefe8a3df8b6043f22494573d032908fd5615b11eeff9064e5073ce9cfea6e0cc219f074a9cfb8ef5ec6c3ee661abc9dce70b12ffdc3305c00ed67af6aef384e645fee8fdcb52828505893da8d6fec035437386ffa135738d7d2c01497dec5a092d6310a0d8fa47be139cb8fc8bdfc7c935bbcbb9d23e5be687bf13c1d9e8cbf947ee5f6d5974d610e21b6cedb5c3ba86cd510e15530ac376284491938abfbb55e4b735eec1865137036166586b8c12a6ae2755a96c1b250554aa0d44a723efe6068095e8eaa2c6c2f5451c9e63fa2cc4bf62f929b89d34f2be6774c5b9f1ba9a1b8dcd47ee5fd39a7e7294d7ed5fbe5e12feac8fdfc34e3056b654dfcfa4209c49afc147be1f0a44f3a7decfc2824abff7bd1f644b7cf89a31e5c0aec95041f7817b997efe836be51aef62e11a5aa63af223f6afedd145ccc0a77d748f0f7c270f6dd15202e193dfc97bde01b09c1f5d73f4d17b956fd7603f029fcad9a33c7f67f491fe7c1f3fd7b5ce782ea8adc238a3f5f2b173bd7b84b808b08ebb21ec95623837dc978f9df3af4748877de89c07406c86b18506e4950055833a631c2bffb854dbe1b7b398ef9e13d2d8e2745bee72927213a5db8a29b71b49ac377f56059348e275741aa55b7eceedaa6812fc9897536ec985df29b77f31e5965b5afcb45bece28ebf299c4b52388f9231fb0274ceb5d8ad0f3e89d2f9ec9af2c95602f4ee12d7f54a94ce9bc4bd2f24b62947e99cf4b502a533ed8e7342ad85646359c2880bed728f7d527f97fa5e666eb1d3d129110e7aaee3718bde930ae4774bc1b7ccc509da0152c2e14aaf3b98000d42d748d4243bf6f7af5b7b238b6e2b263758e11abf22e10d42aa103c77e4a8562e3b2fd366cb35e6ea27139bd5a13e24be4f89f7569e54322b0612d7d661a289b43b920af93b1d3d9c498b8523e967ce7dd364a4906e580f02fbfe0d4466145100ef6ce0d4857cbc88787c3026d4b7e56cd4e47a62a4367efeac8878be911ae28df8b3b291faf31a466ad4dd4f0186e1e72c63a5920bbfadd47fd94a4d16572933f59b8bba1c173565a6be918f3a484ccb4fe4a48e7801e3236b4a78a90e939a1cd8d9cc513ad294cc13b37f74d6fad23202c99ac4e5b8f931b9a856db1847d9522bc845747819b074b931dde61364d2b326258ba6813eb27bb5984221a16cd8c847a7968a5cae7a4670c0dc5b39b3a80417f6d6cae8e375d37dcf715d724cc197b9a1ad2ca34e9bf4a4ff394a85d47856e4cd8a3824339ca23e700d63f345790dac56b7f9cb40260ed62bec42f9f0ce9174f2fbd8a48af97ec76afa195b7715da6a1e66d2eb7626ed9aeaa61bb81d3db4618e498df5dcecee1f5bee7dc4017e824ccf2ab5c622aee2ff74c3959f700f67c61b32eb463b9c87b0561ae97907e3c7e10563f281b1e76f9e6b3172371e2f205f79f40c459c71a9f56ae18c488e372e3de7de349719e39cdd37dfe54239891bec0f83c4852d70a188eb9b6b33a65d499e8776b9c95c3c58f45c64ecd5349fee2f535ccdcd01a2c1a0294a5a7e2155c2246e77538d2e0573efd1ebe6309e8ebccc9e42dd5f6c92e74ef1827fbbd2655d69de9e75b06b99f941f5abb70acec65a0fed9ad8e4af17fa7a8a3e6179771af8c2a93f164e7dbfdbfc35ee26efed83c799283f017720a6ffe18d6fc2293e2e35a6246480ec2f70932104071414b44d12f1a4137a8b4f529b0a060b773dfd2097bfbb9849bb747630cbb34bbfc7e4e72f68133f64b3b3b7c0551e53de481ebd0ff0cebb02ced4cc1e96d538a06cd48bb69d0488cb292b649b8c03ca68ca1ba8d26084842fdb5feb6095b5bde8b99ce349cdbd8798f759cc8e2bd957cb508401c2d7465ac4dded6c2ce2f52af863fc7b4a8338e90b6e87ecfb090fab0a61aa175b6a9eadb1bcb07d1971a4f369c0d2215ed4269b3bb8ba5a1fe62d575b0bb0c92663514fe93fa84f8f77ab10da6f68aed13eb84a20808a9faa0407b503743e83df17facd3ad798f460e57c30f42ec34b7695bdd1f7b3b51ed2fb47c1bef91a3f1be2fe852251f5959e777dc6bba7f8ac793acae9f5c95bf3a85a2757889a5d1be82c28f93c4bcc590cf182a5652c8eb602a91ec1d78dc616a532e0efadc5ca32ac856bbc0a4f3e8439d15a00df6ccbe0ebd7ed7540feeebfed1955ff9d21e4ccbedf5e592db0551b01d377c8ec87593f8281627fbb164f07d3b425dfc55cecd4de5440d9c6a16b63d291a1f58fa8a9bcd4bdf1980af3967c9e494db40ea0824c5d62da30989b1cea3226c77bd00d6692be752b7f4f3fcccd9137929a67f7be523f23faac4adfa933e9dc187452e83a0681009b7e8d1f07e9c37cabc6ab3cfa91a62b0c0eee1aaa449ae1f3b88962824329580d4df01780575fcc694e1452af111a32a2edb1d68b68d2127a34126332346c0b16defb1ef69432f78d68f65e2fdc3333dec979918b55742bd82ca9cfe59433da3bde119be29e3f578b51f1cf0346acaa1bd84653ca69c251738ad7ffaa1a1844f70daaf33295331e54cf20320ac92308349eddcfa705a53e8c7d3af9dc33e71893ea2ebacf2bd85c55d62e9bda0eeb373c1bbad05b56ed43fe5960bf62ec43b7ae621ded8c8656d61ea4ed61bcf658a97f36855fb4a72e6d298aaf0fc7aa506a1f8ef61bbcc679d794de9bf9f168f47e3363807e876852c9ef681b24feb9e5273e502a2680cf35a7a3c5e394b55950a575289f00c6335b3b7bf46e103db20eb4b12bb533789919dad10dc5b39dacabacfd0015bba0f308df075ad93df22343f5828d2bbcf633956a43c5138654bf217f31e0dbcef478bc2bee89c7f9121ded2d87deb468ce65cf7e73aee8874a76c6276ad0a098097baee7d73987ee75d8c9d155e6f51b2eccd332631b9dd777075d5a04b6df50c0e69d99234f5dde85bdf3dd6bef7c27a9f70fe2d3f22ef2f72f9efdd8e71c97bb961a63af84bde0f5501c6ccabdb6fa7e81e17d15f78bacbf9aa891f3f2af685fc2f9d7d391ecdf1f4f114ab2cfbf9f9d67fff85c050d92fa4a8c5abefd595195fc466a0ab5c64d6540c8559882a2ee7e0e6a193d66193c08b9f01b0ff22fe34152cbab14240417345bc1372c84c042bc6c98e8a54f411960eb4f1d935178f3b627799963f8f5345c59a12d0970c4d2c8d8e6af71ddd3306111982e6a87921804f96325902c90a46cc947704767c6e045054214430013e544b5c30bc13f967441e9f79f7343e976dee26232c62965debd35dc5549a9802737c877bb782622922ee399885cd30d99e080c2e6b82aef087ff1dbd40520bcb0d71a14acf34c4dde733e20bec5b51e02927eb26ed678ed334d23beda0047a2f06de12eae094ab1d7d3e96fa6d998ba36aa76280c47d1d753efa7c0cca44cd3c6d0353520bb3858e0be13d966ee7b65b78fcd4404f5cabce77c4a861fbe39b886e843916029c4f6267ed65fb0972173dc4410b03d225e5ede411a6ef17c1fa5c8d2fd7299b020f271203d2de92fb6f40aae2abe5746096795da130f992a9452210ef2e18d39732e17df8fa500c032f773a900c6de572453cf44e6abcb48fe9b93becfedd7d19e5a4e26174104c66afeddaca7072bac833ad50e607dce66e55950882be982aac452bc85cffd2ee9f11896c896bae5a5f17b92be9a9ba3ffcc8d519393c22d23b54be06774aa3f153a6421f9bf9ce41f31167715fcb65dda6193c4665587ad26356a5265e13fb1790d872deaeea7386cd1639670d8e20bbf1db67fd861db95f6d4ccfe777d69517d29e569a80f1faf05489f2edf7a80df7a80293d40665d713140f15b1330af0948596998124fe57bb2bc7b24e0f24fd307d41220f3a769048ec8def4e7b40113af9db31f907bb2bcac027d40fa0cfc688dc01468bf471523f0340253513d33deaff8d1802c08a6b24660b05695c6d16ddd1da87976189b83b965768fb89fed04dcce49c06dc46652285376bc3e4867903f4e1e3927c8f9d05b354f96912fde29a050ba54d8f6fe62b6ec2739b7f8cfcd1f172670a30ca5545144ac084c92d832d36fcdc4b76826fe7ede1f7e6f4ab8d3f485b12f7dd314aaf9d28d9bfa4fa1515d30f12ad5f051773fc397c68f79d9974e2efcf6a5ff415f9a5e545c473ab44c4d74d6f5bfd9897e641bf4e26a168abb9919006f2154816dad76f3d74cd27736e89128fdbdadb41b764d177a861bce0ded9729813374daf7ccc16966d441ebc3cfa70c638705dd6326b543ab252f417f424d366f0f5030b31a0ee5ae45d1ee685b4769872e46bbf70c30701efc540a52a2f8e05b4d3112186656f2a40e9e7cba16fa240af38ebe9fe5106bc1de0ae5b3b3ee67f9f48f710a03a35e7b35793babc1fc981ee61b7903d5077667d4a4ae7b8214e72f53f07ae7d48197730e617c7a808235bbbb99b9a28319e4733bbf7fc8be3f48cb1c81efdd51160d55c9ffae371611721f2181277dba0fe8138f05c88461f476a6ef90a27e4df527efecb27fc71ffb5863871d6c487fc736b465cf84b9d2dc38ebf669de59798cdfedf15a7865df3338381d5d88d307ebc5d68294b90ffaa32352b1c54c49536382debbdaba4dcd194eaa8c423a072b24cfa7dc52a931f7c50ea9b477d06deb6d7d3c099af713416b6319c0dc733cd7f6b5b9a9bda8ad28ad8c04c981bf77a3f56da97d601978c3cedd8f11d1c63146de6cb382f41420ed8e30371d2938cca429fadd34faf9a041d591d116d4967aeafbb7a7fef8f6344015a727cfeae881aaa0ea450faa60e07b5a470f3842dffe2f5360f2754695ca778749cd0a9cb526827334eca0bd859ae7dd85a5b8d0de06f604702420556493ef4c4ec7c8304d390f34d4017fe4f8ef8c77771a06f2ce3220750cba468ba35d1b046ac77d81d4a1da098eee182a8ba6208f2800bf2fe22787aa98715405a7427ab6250b33631fa8ca83e728c1129808ac3182620873658ad3625302c560bc2712d4cb554bc595eb884335a4526eb10e439d11f88bd910fab63458a07d34b3df1505dd50aa309477f1770b09dd728e7dd2265ff89f5165d84d9cab8855a58fd29450e15c14602c0ee4652bd2597d89cfc8989f7683f987231daf1bc243fc9409f4663ff80cd9a2f3a8d30ddc7510b8c0016ebc0ac0b0e0ac75696ee8355509f640ce953a170b3e49bfdcadd5d15e9e96eaa9df91eb4fa1fc621988e3f8a6bfbc3b391defa7aa34fdf91ae692b89e9ba31ba88073d77af8742ad716a44e8167f7698d38c47faa1d48db6adb99d4def58cf66e6eb887a7b5087be8ce9aecfc967ff99e6a0b9fab6b38b71f6e20886949d3a3a334364f12545e4ca541eb75abafbac3e9c6398d0577630b6d79346d3f4d45fd45d3f5cd680affd7e177f2683590a7f0efb4ff3a7e68d746d1b5f77de5b53dd5adf1e0bebb9fe8d3dfd679218ff4c1efd16ada18dcebe17425c275f03b5d5f35c7e3b37e6f4c832e04e899f33a814bddce36d82e63bff7cc19636d6d649fb49733493fb9f7824f9ef9117133b74f788f4848175bf2d64aae4bce8b7595b9920ef443aadd359026d0c1927441335e0364433d741b6363f4d2ad01e923c8ca3a9bc77103de91371c956aa7064130bbe61c74bddbefad07a165b4056b8ae71e9c67ad85305782730f8d857e36ceb2fb38668e2119e3f4da4cec8a22fb29b135c186c5414d87b54ea9eacd8c2d15ff1ed91606aefcf185bdb36e6f1d495f61fb0ad9b2a6d824ac2ae9fe920ffbda7d72dfc8c606189f6d4cbd4c503f0b71603d07cb9ec7cfd0f86522fd7219d974746024738f2c3b15ea13491c9822868840501b9f7b6a7cdee69fbb8009274dc68a6dfcf89dd2b663f2733c1f32f7c2e8f69794266109b804f9fee7911fee9c7999f04e72591cdcb995aa521dde88c2cfdbcac876e93ac11dd4dd4f014a448f590228115ff81ddcf907833bc992e28676889ce4377a1da1d759042eea8f29a8c557e4da867176a54098a35c13487050b22db9feb67796d9155425fa3771f1e47d84de4e0a36e93653a6bef7423f17329f66527b6f99dd059d9fc1442e1e41a0937bc1b3baeb606519b751280014f615fd487fd70d85d7fefd1d1d664be48d7d20ec6a37c08d466196b11c112edcd76f7bb5e85e745b0ee5de6510ebf0cca1ba6c8c6c2938402801dcc6f85994b600aee55407d3b1b902d7d2f1657f6602b6400ba0a0d721b9a1ec3ba2c6c65e379710e670d60f34be60612b7ae8b664fcafb8b5d716c8a3a0e24a55c1e192d43c4acb81a80a48820c80a8259c8db3f24e721f909f500c39331a91fbac344006f7ac3e2ca67a5b9e60177aa12a3067dc33ae7258803b0c2101670d5cf8c9f33a61d2175d69ff06521ab523bfd83590696daeb17b8e4d18796b6f20e7d95ef538f396e075a23900fdacfb6f0a53d6c8778b088662dc04cfa4c5e124c2bb8d240273d50c6f41eba2e75b0f8e9642bb17f8ff6937fc1ec639c2ddc8bb19e43195e60e3052c37117fdad47bd0f5569862a210d43a67f9e7c6684e62dfc5e176cc93ace6afaf99de42a343ff7d051f403d30ccdac07063a7a6b1bed8dc541f442183c5adb29122f0fef0be9706d4e8e480649a278cd617281cb7336913c6571a8e790dad63a0073df9ba1dcb32c24cf74fb5f18bb992983090fef36b037d6d659370fc80d8ce7047631a930281913b267c6e3d9baf36ca901f97128006eaa6b0ddee51e24847a6b37701f00b7062ea7d67a9e06abc7968be648375c1d70c8d31b8eef36ddf3cebbec9ea2f5845c534cdcf6f238564b23a95bcbd7f30cb9431156e151699f9f8dc652552cd15e47aea2daf2b66a6717adad8eb5707c19b92833533babf7826784eeab1334f1339cbcae04ebbefdcb36da82b30eead1ef600de9bffa1315ff5f5fd8c1ed6b3f74766acb151d65f06b36765d1282011cdb63ab1dbbd0737330b18c81e8acf57b141e07e2a4ceebadda7a78e90add8583d6acfe60991a10101e1ddff140e3c39500ffd5ac3d8ee5db5fadbb05c8cace0d77e12acdb0b71681a865a12910721a044eb8f29e71fb6a4b5ed9d2404463dd6a6c2c536bdb4af40e479ba06bb5bc0d7556c0f89390b6371ccbeb99f17ab62678cc5a777168943cdfaf1176ef3a5af83c967fc673271adf8860b3d605bc13dc6fe14a6d20796a91fd445340936320d83515852d22cc4c3304dc19094f3ba1b725eda92d599a99aa6725df83fb1e2cd3f146eb7688cecca916b840e0a534c3c7ce3e7ec74fd4fb46a18a0ec8ee2ed6aed188d24ffedd8be5af3c20b0c02941cfa1fad35b43d8fc55b414fdfca8ccb64fa1dc7c34855cdf889d311c5f1e7b7cede6911a63fcbb8353eb2e2752f7bf303e303f67a6bb989b23aff8dda3767490249c18fa6a6e3cbc3c63fbe4c9977f5aebf612cee589d15c811c5717c29deb20b4a5069a13dd9ad8a4fb42da8cfba4bc2e9ec7a9f771f119896d03fb02d963e079ac751b24d0f09c919baa02e1ebc18bda5a04ce464dd66aebeef2fe21358ee5da81b98943e64b7cff8e80c30cf8fd4168d744f3ea6c995a17c9336fac85dd5a2c9c5a709885726ace3ce6e73db65dee9aaad2aecd0c20274be6646f8d7f6e2da4b9a10576b83acca4b63093bc176b836c110823798fadf45c3444e1a7da49cd37a8f47a4173deec1f6c086fd4b417f55e680ec777a754bf186b576dc949085d19406a241ca3cad3ae34334e2f781ce8f6707f217c3818ced65b4857a0f7a177ba8b99b43f3fb65607386b35a3bd9cb7d26bcb8d9e47e86d60bddfbd3c4e76deaff12ae9a3f296f3058fb5b83b99e34638335d234a6d54983bacb3877cbf23ecd47b55ecb7a27efe1aafd0df86ad6672de54c31c7d4248eab0dd06fef3ef44a8aa383495bb3c0e5109b5aa21aada8d505d8c4312ae12a1126a9f15a1424f29948850c5177e47a8fec508556e65f1235531bad8ff568c2ba9189720b2ffa8525c82042fab14974f6424f7d0d641602bda59f5ef0ed924f7bb68ae57ed15f00c90c4cfd0e3ca7d247371d3e7dc771040b4c152a2dadb91d43e00a82c837ece56d0d0c9bff8673ad9739d7a58446d04f4b14001cd19db0c67c0bb69d3db7b48c2214acc0908fba39aecd43b7f6b24270681c0bf59fa44aa0a21e63328a07c8ed701fdbbf729c1e1a837c84934eec9cf05b4f1efa30e266d65aac6a84a9ec3486a1e5c589f79ca4a7afef1f69ca25a7b58ff24d2b8b66b6a535deb9005005e8c368a44dc977ff796017bf1c9cfb7cd52c5c3ed143cb786eef79aa3d2fc80777e9a2bcd03ccf7a9b238daeb292b72c5064b6cb4c02e39df9de8ded96865b2e7fb8d11ea87f919eff915849e7f218f08d6390203cbd3b921066a6bb19a99c1f9b1c2baaf48010e9ed9ca04708101d15c710b11e87eab682f40fd45fdcb4bb2e4a2a4efdeff66353d74d6e0b9c23983c18aeb41e3a3f601d7ec8696d1584694e8ed3af17c915a64d1de10f733f88c75f2c92a998decfa401c200ee19221721d13f65af9304e89d8084e04e9cab9a3c9f5c41ffd29de5475479b75a92e56f647c56bf8a3a8b79fe38ea2a72ce38e920bbfddd17fd91d4d5656297ff45b1a32250dc9e400ccedbd5461055db199b64d92b3f8f18d15f9453270b41ff7c13270b4ed91e3f2c3a0f1546c22e9dbcada5ac6ebca0955af97a5cb7f5fa678328b2a72f399e21c2b43d27fe8072b3b5c82efa9acb4d35bab553f2f72bcab704eefd267b4248a5563c675a9f9f367f5a0b17495a0b1287e56d0387acc12a7747ce1f729fd0f9fd2bbd227f4e69bff89f03ff5270facd339399937fde213997bea3ff82523a6c799a1ad2e9e4e3e949175b710417fbe17fcdee40184ba8ea8f4674da2bcea8f91a1adf25155a605c11b978bccb9f4a9584530ea12836e119f0b5b340145bbaaf3db90885f018b2e87cd368eda55fc5e14799a54fe5e1f22396a5bdbbee93993284bf5effe790e207a0d5e930328b5f60a3880a852e7871f051c2b493b60d9ae503416acea6a3c3c2d1c11869246cad28c7ea71d9fd0bda3a86e228018ff1ff5b132074fd46e1c15a5db75157d87da0ce4175b6a04719bc9ffdfd66618cd691d22a3108955b4c5b3d92fcd71948ac8956a93ffce722caf71e4af9b63792d6476ade950ca7786d23226fed904c17c8c7d5e9e8e99885af673d93353f49d554d88bea83d4f559a07e2250dc7dd183f0451760bb05e636fa391363b3413f6ed86c25cf23e6ff310595e21e35339c349958bb3df9516ceccc10b8881f5a4d50f9e3798fd640519191ffe1ce7eda51784be2eee7f30ffd38258355cbe4dcfa722e12ff26cdfdc4cc0cd74d8baf3fd73095797be30f6736f05a99a9fdb907ede342b07a3c5db2b95ef09d2e7f8b9f8312ffbb9c985df7eee3fe8e7d28b8aebe426fc3f7faf83fb8837efec7dfe356ea6b3db0976c8890210ba8452ef878827e8f5e82c5f3c67edfab3b1f7dabb7fd8f5c7a7536ff9100248d8958295ab7837fdc9dda94b017bc967aee85b4b5a084fcb8753bf35fda92adad6a941a8797583086def7748ed33e32833dec960e1ac477b575988b6915384deda86e301281f8c4bcb6c8b7353de5b46e3ffd9bb9ae6b67126fd57def275674249b652d1dec6c9c4915fc73b8e13c9d1d41c4010246181000380b2e4aafdef5b0d821fe0a794c9666a3739d822ba9b204080c08346776372735e9c643ab147062c9ed0fa168cadd94d923e6d8ab820e529ba93f2f4dc669ca1b6f2c1c46e32713dc001b1a1aeb7f744fb66bb8389913f0b0e9f1f2e05b9bfeca04db459b85f9993f29feb65b006e1f65db0897ff5c92e50dcb2c36972ef5df0db52a6f4d07adf3d80d4e06a9535fb50f73d47c7c732a0e27db702632ca653baa999a5a0f55d6592526bcfbcddfbe23955ceb2abf57c8a6779d0e4ae4570abfdd77b86f91df41ff8ad9f6edbba77f9faf213c4e1b0c74141ec910c8eeb585e5dc3e9df62b3be95e088038e6e3706eccd17e17d3b9f3a90abd37b4f36ec3af935593d6f1eae679f1f2058f8fcb93b5e4fb33d1b8ec20f418c93391c53d4bd70787bcdc0d1054e0137a628d4d4e9015d2d16e1fd658293858632e70162af27f5d8551fd78b6cf9260f7a5b9e38c9a78be5bb0f3b73cf7695390172b75370f6b071b27edbdf7e144fb7afaf3bdfdff2f5e57db1e8420f77d1e76405632abb797d39f5933dc3e7ef23b25e4c2196d3e6be5ee6af6e8b7f5726398d712057fa2568bd521b3bafb8b449e5f8b8b6c77af59be7d463d83463b719d3d94d6e62193eccace917b49b13b36aaf36eb0f94c0b133b378575b349ad869f6f99d314ba02f7f9ec5cc8c870f1f94c108c57770c23d10f3049416e008651686e7663e2ddef12b136bacf60d9afaade124d05bb159df55ef6bb508f332395bc23df76f943f7b3b8167deac6d5b59676df31e1ee71bff7c7558fe5e2841dd32fd9d3206efaec13430ff8e1e9dd82e55be8f35f3b54894a64ccdb116ccb46ed6f996edf2aa4dbbb9afdef3c68ee1ed984905a6bbce632f9aefd76d37a78fbe5d8456e9606250390ec7577bb6b9ba9ec31171c1baeeecfc61da3e6d1c68f5a0d7cba7f76f3eebf76f7ed7efdf2c69a9a0f8c1fa9f89f5f7382fc7ac3bbe6d95e9bbf6bfd63cd181ddea636324c630422346d3fcb91b4795caa746bd4aba133be9e67e7af8bc66cf664e3572b7314e2086aac5cc8df2167f9db27452e65b7c57081c29ef7fab1f73d5368378bc18c5104e1dd822c40903c528f4dd6e6c0679b4623436624f5953c6e5e37c85679f6270888331bbd946a68dbb4fd82f9ffd9a36dc0522e1b66fc3e9dbf685465e56c9346c76d2523815fdabd8c8f8df573aa9ba3fd0b0dac9112d144fd3d9c5890616f3c5cb57f38bf3530d2c262fbf85e2292fee77513ce5d53c42f1540afe543cfd888a27e7b31a503dcdc05bededb6502df578272d014a16de49c530d2237bbb59cfa785acd90bac0dd3f9d2f76dea27ab43b12fdf1aca1fb660f5f8041e799f13accdf38c6dc42adb18cb400809f961171ca6cf7eb5dc6dda04d0fc2c8725dc0f538e06db83c0f5746b4ca3b0073db9684c37267ed4c76475be7c0bcbbde53f69ed38c5b3e33df0fea665bf7956e1f5b582b39c120bdb4ff08421efeee851d6fe1cdeedb55926d938548f9b75bcf3af3e30fc38a1b5e73b90da2943db4ee46f7b7d60e8b7e7c10e2777f40f7619e3f3db73e85727787d3c41dd83d9e2b039da2ba87ae6f2eaed34b882782dd3271fe28180674db23abf79a8cae22c59df74c38f3aadf19dd83639d24b881bfb1d389d197e07bc3d464340d7dadee6198da95b16ea6bce5a336a3d3e12767940ed03b0cf96d9b9af0eddbe7a6ff7ebbc57ed38f01dad787b2ddd373bffbcb08affde10b3b2f11fc798956c09324f3fc57532393f3934e9e49bec6d4ebfdf19ae50c9c931811f4ac19f10f3478598d557358631cd09d43f233e0c457c80798a010e5a6615de019ba57f32f2c3eab96e5b165438ccd8989972d2f9aac48ab0ddd761e3f54d3159b280f880fff1097e073c924f3a73d6e6f99a0eda77defbb33dc387aeada1de2320c00bd71e1172c9f0fd6fd9f2f55df1de4c7edfffccd76f8d096b58f471fe5f10a7ee5385614f582f3898f6182f69175b9b589cb73bf0c0025bc33feeaf21669eaee3e976fbb6e28182ade76c737f0963ce84dc5f4efcc3a5dcac574f36a665e1993d59beabd77bfc1d97aacf7658fc6e1c573ff97ff6b6efd4ff7f1e1bf27115e477f5f0ca54e959328a0b550313be7c75712a263c5f5c2c4ef6ec9a7e9370607971bf0f2a34d53c061516823f51e10f8a0ad53188101f7e6a1cadc671df34da31f1bf0c12bc7e8695f4fbda4cd2d23af4e4d99c91ea5e0306c5fdf4e2fa7a2f2e56692b7bbd8d7aefb59ab3933da3aed967781fa77b725d5a0d72ef33fbace581def7cefe78d7b29677fee05bc58989aa1dddd8bedc692055d4b9c79ba0a8d7e6cd45671fb6de5095b6faa3f18a7257248ff316eda493d20f05529f1cebf513dd6ced2152ebbb9e43080b03ab65746cbebde56cf9fa1fb16a2b5697ff9c87909d07165b58fdf418d9d69e7ba7d17a6e8c4b1c23df8e3f30c6b35ad7c59297870702f24dd0c3b5fef7ebe077fbcca8f53ebe9d87507d07c535d2a98d1f3f3d860a8fa1f62a16c69f62ee776830c67fec9857aaeb5accc5d334d7fd3117c7e345e5e8961c0d6f9ba0f628b0950032ec82bacec322e1f9943bf75b1c3c5e260b93ff3c7b71f6578993b5cc5a305941ea5f0149090f08c787fffcd7400d3ca5854411a963eb3fcf504a5f44e2ec973394812ad15eea382762912488072a4fc172c95ec24bc8afd26d4482fc529284f200d67e3629644024f0fe2a5eb479a69f851404fc43beb0c0826bb2d767bf9c118e454079e43d2a012f9948292488840c45f0938054bd92486e7d037ca11c7290e9a5db08f24e4872f6cb505b7be65d8cc8e04c699110a946e4c8978ca609e163f951be235c0b79189163c8276ceca14c60a4a9e063725c681a8e3dd1b4e2584e6926718c141993ab3ae1a054119f62442e532d19b5a5e9cc8bc4af5f24168179906088472f848cbcbda78984d6870552b96a02d5418222e23da6047a99e99c547854649ac27e151340e6447bb1d6a9bdcc24b0043c3e453af642ca085c802e4348686ea525167c975f511e81a8d9dffaab58c0767c0b09e48f45924aa29417daf29584e8993a02ecf9a99e7c66d4b75f14a29c488f51a59d4f0ccb43aa4579e121a2aa04a6694c64950eeacc40a12a4170103b298719cce6f3e9a246608ca69ae28a12d2544d2f261521de06612d95a09a709c6e4995a25c13c911f37c21298f7a199eefd301aeea6462c195465c9b766ab309d752a4076f377d317931e91068d5abc9715f7817d78b703224c1281acac1a751ae5de813c031c1db017e20fd6880edb67c175ba1217eb36f74483c2119a853c4bc9012365467b777b5d94e776bb113365ca7846dc9509371aa34197a402ee08514e9012939580815a3d9fce5b0c0f9307b3e9d0d0964be6664404033359801f0074a80118e07b20f48aa3c1826cd7c342287d36c44221201f1b3818e6ea47a86012b122335f02908ce0e1d5c9aa4ac832c11efeac040b6b35093a50ecabd2909e6b584db671b5dd4bd51e28b5aa27e9b8ad1d449395dcced51cd0ed4ec2f9ad5862dcd54eb853902fbf9a4f6f543ca4bb7747f962b357d0458e20b6b24bd40d21d914d6af1204094894f821ad2ac834ea4f8b49e865ccf674dcacb0b87423992873a25127e3d19937d17b0ada79df27532cc6d8081d5b08848f588c41395a425f1a84a78e032764eed5392d493fb841d03d17d21123f63447a3e2a21d900db0399e9ecd59818b47b82b4a4fb314982f898087c620d201950056f2e9206497b3441b07a68c8644a530e6833ce12c46113c8e193e069478847f6340c9be58cc4af69107a611a844d869f852162c2338b598797a02dcd704c7f0dbc483cef5b056af33d58c3097ea49817104db016f258794948a00413273cc20e67c3b27dad3b28ea05045a539e76cb9115aedd43f8718f119c04a342d53ae508b1a36b588a1f593b2bdf53b31316d95d7dd69528c7a304a56a58345fb11f23e31503fbd7acffbbe5940ec4d802f4a7a6e0ffaea620a11ac784b1d88cdf2221603ce04848921c441688248d8999eba3306cb48e7cc248324560128061de8cf523229a86e1a8d2a297e9f9542ba287651ac3725d036211956f7efb984c3c8554c54d364c82c4f393b49b8131d5ba9b55d4b99b63d5180e571d949771ba6fd2ad06a741db6baf0026834c0fc74826281d16a288235065ee47c4ec48769c944703c2350d2991c3373ca21471a2c8b0d456480b6cfa65142c36e089018ee978965aa28002da41ec28f98cd3a2bbb684ca5a673a7c55243a2565c689eae46889b80a45bbbdf725eaec22bbed926ea317947b0794b01706cfda551bfc782860449e17540f4b9c27c0a2b6474b586a0bb16042ba292f458ce89a463190e8a94c44342caf8b22e69f878544ae16d22452d39f4b69e45327a910afa77daa08d60ee5a009624e1ef5357449c431c2317a65d7851559ec088cc29ed458ec1c4e9ad59385f693514d1c7aa2ad36b4244502491cbb94622dde24299746f62991d4ce9135ba70e492c65be1446b89b0532ea14c5fa99352c1989396026a250916d27929cdbc240919c1ba59759971501f78488b84e22e0e8ea4c8d22e0ed9531d0bb1ede2459d7945d85318f12e96c5591d741d77d1d3548a30870f5d6cb0abeb2663c498c728cff67501854222a9704894478c848c46b1d39295c6bc4e02d579f3e5aa03775e03a435516e6eb644644f30e1bb2e969d604a3a64916bfb2b123477fe7f37ab33320e358b09b29f92dd35085563f7806ac3cfb365c299366cd3d896801f4019d5a52eb8857ea8bcf64c61925c3f053f5e92314d53643e3643f892094d825452ae916f34316058293936c325a33eee20413b7afa9012d560c2936d8d1c324972285a27aa2fb6bdda442fdfa1e044b7b7546077c5a48bcfb524d65e598be6218529ede4406ad6cb2957c6dd6c15ee2c8f134d8b32aaa42c2ecc51a9145ab477848432bdce243d45a37c32b2db43cded223b7e982dd388ecd3f2c25307ae117c4cf623abae3c1c895aaa183f15a398a8e12d28fbe9c04f3536d90f0276a8608aaf4d7a4eda4b913428c296a64200f6cacb74387de9a641959371fa25cbe5e0ab818b8c4287de111e08e975a1d07c4e9a4d8e934a053b4ccf27f3116993354c81c7ca156ac101e1b2db147b33c7c88e9417fa5dc09517709510a572e0d127587e3851a6d53172a914fbc388e0cc8b5384b7035234e0a8870da348ae84efe29a3ea508ce24f17c1a5099b1beeab58060af50d1e320c363e4780e2c9f08da9efdf5ffcc8cf8bfff070000ffff0300e82d2f702e500300`)))
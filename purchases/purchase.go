@@ -0,0 +1,160 @@
+package purchases
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/medoix/warehouse/inventory"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	collection   = "purchases"
+	purchaseYAML = "info.yaml"
+)
+
+// State is the state of a purchase order.
+type State string
+
+const (
+	// Draft purchase orders can still be changed.
+	Draft State = "draft"
+	// Ordered purchase orders were sent to the supplier and their goods are
+	// expected.
+	Ordered State = "ordered"
+	// Partial purchase orders had some of their goods received.
+	Partial State = "partial"
+	// Received purchase orders had all their goods received.
+	Received State = "received"
+	// Cancelled purchase orders are no longer expected.
+	Cancelled State = "cancelled"
+)
+
+// States lists the states of the purchase orders in the order they go
+// through.
+var States = []State{Draft, Ordered, Partial, Received, Cancelled}
+
+// Open reports whether goods are still expected for purchase orders in the
+// state.
+func (s State) Open() bool {
+	return s == Ordered || s == Partial
+}
+
+// Line is a line of a purchase order: the quantity of an item ordered from
+// the supplier, how much of it was received so far, and its unit cost.
+type Line struct {
+	Item     string          `yaml:"item" json:"item"`
+	Name     string          `yaml:"name" json:"name"`
+	Ordered  int             `yaml:"ordered" json:"ordered"`
+	Received int             `yaml:"received" json:"received"`
+	Cost     inventory.Money `yaml:"cost" json:"cost"`
+}
+
+// Outstanding returns the quantity of the line still to be received.
+func (l Line) Outstanding() int {
+	if l.Received > l.Ordered {
+		return 0
+	}
+	return l.Ordered - l.Received
+}
+
+// Total returns the cost of the line.
+func (l Line) Total() inventory.Money {
+	return l.Cost.Times(l.Ordered)
+}
+
+// Receipt is a delivery of goods for a line of a purchase order.
+type Receipt struct {
+	Item     string    `yaml:"item" json:"item"`
+	Quantity int       `yaml:"quantity" json:"quantity"`
+	Note     string    `yaml:"note,omitempty" json:"note,omitempty"`
+	When     time.Time `yaml:"when" json:"when"`
+}
+
+// Purchase is a purchase order to restock the inventory from a supplier.
+type Purchase struct {
+	ID string `yaml:"id" json:"id"`
+	// Supplier is the ID of the supplier the goods are ordered from.
+	Supplier string    `yaml:"supplier" json:"supplier"`
+	State    State     `yaml:"state" json:"state"`
+	Expected time.Time `yaml:"expected,omitempty" json:"expected,omitempty"`
+	Lines    []Line    `yaml:"lines" json:"lines"`
+	Receipts []Receipt `yaml:"receipts" json:"receipts"`
+	Notes    string    `yaml:"notes" json:"notes"`
+	Created  time.Time `yaml:"created" json:"created"`
+	Updated  time.Time `yaml:"update" json:"updated"`
+}
+
+// Update updates the information of the purchase order in the store.
+func (p *Purchase) Update() error {
+	p.Updated = time.Now()
+
+	data, err := yaml.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("purchases: could not marshal yaml file: %w", err)
+	}
+
+	if err := Store.Write(collection, p.ID, purchaseYAML, data); err != nil {
+		return fmt.Errorf("purchases: could not write purchase order: %w", err)
+	}
+
+	return nil
+}
+
+// Totals returns the total cost of the purchase order in each currency of
+// its lines.
+func (p *Purchase) Totals() []inventory.Money {
+	sums := map[string]int64{}
+	for _, l := range p.Lines {
+		sums[l.Cost.Currency] += l.Total().Amount
+	}
+
+	totals := []inventory.Money{}
+	for currency, amount := range sums {
+		totals = append(totals, inventory.Money{Amount: amount, Currency: currency})
+	}
+	sort.Slice(totals, func(a, b int) bool {
+		return totals[a].Currency < totals[b].Currency
+	})
+
+	return totals
+}
+
+// Outstanding returns the quantity of each item still to be received.
+func (p *Purchase) Outstanding() map[string]int {
+	q := map[string]int{}
+	for _, l := range p.Lines {
+		if n := l.Outstanding(); n > 0 {
+			q[l.Item] += n
+		}
+	}
+	return q
+}
+
+// Next returns the states the purchase order can be moved to by hand. Partial
+// and received orders are reached by receiving goods.
+func (p *Purchase) Next() []State {
+	switch p.State {
+	case Draft:
+		return []State{Ordered, Cancelled}
+	case Ordered, Partial:
+		return []State{Cancelled}
+	}
+	return nil
+}
+
+// CanMove reports whether the purchase order can be moved to a state by hand.
+func (p *Purchase) CanMove(to State) bool {
+	for _, s := range p.Next() {
+		if s == to {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements the Stringer interface.
+func (p *Purchase) String() string {
+	return fmt.Sprintf("{%s from %s, State: %s, Lines: %d}", p.ID, p.Supplier, p.State, len(p.Lines))
+}
@@ -0,0 +1,327 @@
+package purchases
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Store is the storage backend of the purchase orders (default: the
+	// ~/.warehouse directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
+	// ErrNotFound is returned when a purchase order does not exist.
+	ErrNotFound = errors.New("purchases: purchase order not found")
+	// ErrNotDraft is returned when changing the lines of a purchase order
+	// that is no longer a draft.
+	ErrNotDraft = errors.New("purchases: only draft purchase orders can be changed")
+	// ErrNotOpen is returned when receiving goods for a purchase order that
+	// was not ordered, or is already received or cancelled.
+	ErrNotOpen = errors.New("purchases: no goods are expected for this purchase order")
+)
+
+// idPrefix is the prefix of the purchase order numbers, e.g. "PO-00042".
+const idPrefix = "PO-"
+
+// mu serializes the receipts of goods, so a delivery is not counted twice.
+var mu sync.Mutex
+
+// Purchases returns the list of purchase orders sorted from the newest to the
+// oldest.
+func Purchases() ([]*Purchase, error) {
+	files, err := Store.ReadAll(collection, purchaseYAML)
+	if err != nil {
+		return nil, fmt.Errorf("purchases: could not read purchase orders: %w", err)
+	}
+
+	purchases := []*Purchase{}
+	for _, data := range files {
+		var p Purchase
+		if e := yaml.Unmarshal(data, &p); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("purchases: could not parse purchase order: %w", e))
+			continue
+		}
+		purchases = append(purchases, &p)
+	}
+	sort.Slice(purchases, func(a, b int) bool {
+		return purchases[a].Created.After(purchases[b].Created)
+	})
+
+	return purchases, err
+}
+
+// Add adds a new draft purchase order from a supplier. Purchase orders are
+// numbered in sequence.
+func Add(supplier string, expected time.Time, notes string) (*Purchase, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	id, err := nextID()
+	if err != nil {
+		return nil, err
+	}
+	p := &Purchase{
+		ID:       id,
+		Supplier: supplier,
+		State:    Draft,
+		Expected: expected,
+		Lines:    []Line{},
+		Receipts: []Receipt{},
+		Notes:    notes,
+		Created:  time.Now(),
+	}
+
+	if err := p.Update(); err != nil {
+		return nil, fmt.Errorf("purchases: could not add purchase order: %w", err)
+	}
+
+	return p, nil
+}
+
+// Get returns the purchase order with the given ID.
+func Get(id string) (*Purchase, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
+	}
+
+	p := &Purchase{ID: id}
+	data, err := Store.Read(collection, id, purchaseYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("purchases: could not read purchase order: %w", err)
+	}
+	if err := yaml.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("purchases: could not parse purchase order: %w", err)
+	}
+
+	return p, nil
+}
+
+// OnOrder returns the quantity of each inventory item ordered from suppliers
+// and not received yet.
+func OnOrder() (map[string]int, error) {
+	purchases, err := Purchases()
+	if err != nil {
+		return nil, err
+	}
+
+	onOrder := map[string]int{}
+	for _, p := range purchases {
+		if !p.State.Open() {
+			continue
+		}
+		for item, q := range p.Outstanding() {
+			onOrder[item] += q
+		}
+	}
+
+	return onOrder, nil
+}
+
+// AddLine adds a quantity of an inventory item to a draft purchase order at
+// the given unit cost. Adding an item already in the order increases the
+// quantity of its line and replaces its cost.
+func (p *Purchase) AddLine(itemID string, quantity int, cost inventory.Money) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := p.reload(); err != nil {
+		return err
+	}
+
+	if p.State != Draft {
+		return ErrNotDraft
+	}
+	if quantity <= 0 {
+		return fmt.Errorf("purchases: the quantity must be positive")
+	}
+	item, err := inventory.Get(itemID)
+	if err != nil {
+		return err
+	}
+
+	for n := range p.Lines {
+		if p.Lines[n].Item == item.ID {
+			p.Lines[n].Ordered += quantity
+			p.Lines[n].Cost = cost
+			return p.Update()
+		}
+	}
+	p.Lines = append(p.Lines, Line{
+		Item:    item.ID,
+		Name:    item.Name,
+		Ordered: quantity,
+		Cost:    cost,
+	})
+
+	return p.Update()
+}
+
+// RemoveLine removes the line of an item from a draft purchase order.
+func (p *Purchase) RemoveLine(itemID string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := p.reload(); err != nil {
+		return err
+	}
+
+	if p.State != Draft {
+		return ErrNotDraft
+	}
+
+	lines := []Line{}
+	for _, l := range p.Lines {
+		if l.Item != itemID {
+			lines = append(lines, l)
+		}
+	}
+	p.Lines = lines
+
+	return p.Update()
+}
+
+// Edit changes the supplier, expected delivery date and notes of the purchase
+// order. The supplier can only be changed while the order is a draft.
+func (p *Purchase) Edit(supplier string, expected time.Time, notes string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := p.reload(); err != nil {
+		return err
+	}
+
+	if p.State == Draft {
+		p.Supplier = supplier
+	}
+	p.Expected = expected
+	p.Notes = notes
+
+	return p.Update()
+}
+
+// Move moves the purchase order to another state by hand, i.e. sends a draft
+// to the supplier or cancels the order.
+func (p *Purchase) Move(to State) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := p.reload(); err != nil {
+		return err
+	}
+
+	if !p.CanMove(to) {
+		return fmt.Errorf("purchases: a %s purchase order cannot be %s", p.State, to)
+	}
+	if to == Ordered && len(p.Lines) == 0 {
+		return fmt.Errorf("purchases: a purchase order needs lines to be ordered")
+	}
+
+	p.State = to
+	return p.Update()
+}
+
+// Receive records the delivery of goods for the purchase order. The received
+// quantity of each item is added to the inventory with a receipt in the ledger
+// of the item, and the cost of the line becomes the last cost of the item from
+// the supplier. The order is partial until every line is fully received.
+// Each line is saved as soon as it is booked, so receiving again after a
+// failure does not book it twice.
+func (p *Purchase) Receive(quantities map[string]int, note string) error {
+	mu.Lock()
+	defer mu.Unlock()
+	if err := p.reload(); err != nil {
+		return err
+	}
+
+	if !p.State.Open() {
+		return ErrNotOpen
+	}
+
+	// Check the whole delivery before changing any stock.
+	outstanding := p.Outstanding()
+	items := map[string]*inventory.Item{}
+	for id, q := range quantities {
+		switch {
+		case q < 0:
+			return fmt.Errorf("purchases: the quantity received of %s cannot be negative", id)
+		case q == 0:
+			continue
+		case q > outstanding[id]:
+			return fmt.Errorf("purchases: only %d of %s are expected, %d received", outstanding[id], id, q)
+		}
+		item, err := inventory.Get(id)
+		if err != nil {
+			return fmt.Errorf("purchases: could not receive %s: %w", id, err)
+		}
+		items[id] = item
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("purchases: nothing was received")
+	}
+
+	now := time.Now()
+	for n, l := range p.Lines {
+		q := quantities[l.Item]
+		if items[l.Item] == nil || q == 0 {
+			continue
+		}
+		item := items[l.Item]
+		if _, err := item.Move(inventory.Receive, q, "purchase order "+p.ID, ""); err != nil {
+			return fmt.Errorf("purchases: could not receive %s: %w", l.Item, err)
+		}
+		p.Lines[n].Received += q
+		p.Receipts = append(p.Receipts, Receipt{Item: l.Item, Quantity: q, Note: note, When: now})
+		p.State = Received
+		if len(p.Outstanding()) > 0 {
+			p.State = Partial
+		}
+		if err := p.Update(); err != nil {
+			return err
+		}
+
+		if p.Supplier != "" {
+			link, _ := item.SupplierLink(p.Supplier)
+			link.Supplier, link.LastCost = p.Supplier, l.Cost
+			if err := item.LinkSupplier(link); err != nil {
+				return fmt.Errorf("purchases: could not receive %s: %w", l.Item, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// reload reads the purchase order again from the store, so the checks made
+// while holding `mu` do not rely on a copy changed by another request since.
+func (p *Purchase) reload() error {
+	current, err := Get(p.ID)
+	if err != nil {
+		return err
+	}
+	*p = *current
+	return nil
+}
+
+// nextID returns the number of the next purchase order.
+func nextID() (string, error) {
+	keys, err := Store.Keys(collection)
+	if err != nil {
+		return "", fmt.Errorf("purchases: could not read purchase orders: %w", err)
+	}
+
+	last := 0
+	for _, k := range keys {
+		n, err := strconv.Atoi(strings.TrimPrefix(k, idPrefix))
+		if err == nil && n > last {
+			last = n
+		}
+	}
+
+	return fmt.Sprintf("%s%05d", idPrefix, last+1), nil
+}
@@ -0,0 +1,221 @@
+package purchases
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/storage"
+)
+
+// setup stores an inventory item "bolt" with the given quantity.
+func setup(t *testing.T, quantity int) {
+	t.Helper()
+	Store = storage.NewDir(t.TempDir())
+	inventory.Store = Store
+
+	item := &inventory.Item{ID: "bolt", Name: "Bolt", Quantity: quantity}
+	if err := item.Update(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// ordered adds a purchase order of bolts from "acme" and sends it.
+func ordered(t *testing.T, quantity int) *Purchase {
+	t.Helper()
+	p, err := Add("acme", time.Time{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.AddLine("bolt", quantity, inventory.Money{Amount: 25, Currency: "AUD"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Move(Ordered); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func stock(t *testing.T) *inventory.Item {
+	t.Helper()
+	item, err := inventory.Get("bolt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return item
+}
+
+func TestCanMove(t *testing.T) {
+	tests := []struct {
+		from State
+		to   State
+		ok   bool
+	}{
+		{Draft, Ordered, true},
+		{Draft, Cancelled, true},
+		{Draft, Received, false},
+		{Ordered, Cancelled, true},
+		{Ordered, Partial, false},
+		{Ordered, Received, false},
+		{Ordered, Draft, false},
+		{Partial, Cancelled, true},
+		{Partial, Received, false},
+		{Received, Cancelled, false},
+		{Cancelled, Ordered, false},
+	}
+
+	for _, tt := range tests {
+		p := &Purchase{State: tt.from}
+		if ok := p.CanMove(tt.to); ok != tt.ok {
+			t.Errorf("CanMove from %s to %s = %v, want %v", tt.from, tt.to, ok, tt.ok)
+		}
+	}
+}
+
+func TestMove(t *testing.T) {
+	setup(t, 0)
+
+	p, err := Add("acme", time.Time{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Move(Ordered); err == nil {
+		t.Error("a purchase order without lines was ordered")
+	}
+	if err := p.Receive(map[string]int{"bolt": 1}, ""); err != ErrNotOpen {
+		t.Errorf("receiving a draft = %v, want ErrNotOpen", err)
+	}
+
+	p = ordered(t, 5)
+	if err := p.AddLine("bolt", 1, inventory.Money{}); err != ErrNotDraft {
+		t.Errorf("AddLine on an ordered purchase = %v, want ErrNotDraft", err)
+	}
+	if err := p.Move(Cancelled); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Receive(map[string]int{"bolt": 1}, ""); err != ErrNotOpen {
+		t.Errorf("receiving a cancelled purchase = %v, want ErrNotOpen", err)
+	}
+}
+
+func TestReceive(t *testing.T) {
+	setup(t, 2)
+	p := ordered(t, 10)
+
+	tests := []struct {
+		name     string
+		received map[string]int
+		wantErr  bool
+		state    State
+		stock    int
+	}{
+		{name: "nothing", received: map[string]int{"bolt": 0}, wantErr: true, state: Ordered, stock: 2},
+		{name: "negative", received: map[string]int{"bolt": -1}, wantErr: true, state: Ordered, stock: 2},
+		{name: "not ordered", received: map[string]int{"nut": 1}, wantErr: true, state: Ordered, stock: 2},
+		{name: "too many", received: map[string]int{"bolt": 11}, wantErr: true, state: Ordered, stock: 2},
+		{name: "part", received: map[string]int{"bolt": 4}, state: Partial, stock: 6},
+		{name: "more than the rest", received: map[string]int{"bolt": 7}, wantErr: true, state: Partial, stock: 6},
+		{name: "the rest", received: map[string]int{"bolt": 6}, state: Received, stock: 12},
+		{name: "after all", received: map[string]int{"bolt": 1}, wantErr: true, state: Received, stock: 12},
+	}
+
+	for _, tt := range tests {
+		err := p.Receive(tt.received, tt.name)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Receive = %v, want an error %v", tt.name, err, tt.wantErr)
+		}
+		if p.State != tt.state {
+			t.Errorf("%s: the state is %s, want %s", tt.name, p.State, tt.state)
+		}
+		if q := stock(t).Quantity; q != tt.stock {
+			t.Errorf("%s: the stock is %d, want %d", tt.name, q, tt.stock)
+		}
+	}
+
+	if n := len(p.Receipts); n != 2 {
+		t.Errorf("there are %d receipts, want 2", n)
+	}
+	if link, ok := stock(t).SupplierLink("acme"); !ok || link.LastCost.Amount != 25 {
+		t.Errorf("the supplier link is %+v, want the last cost of the line", link)
+	}
+}
+
+func TestReceiveStaleCopy(t *testing.T) {
+	setup(t, 0)
+	p := ordered(t, 5)
+
+	// Two requests load the purchase order before either receives it.
+	first, err := Get(p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := Get(p.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := first.Receive(map[string]int{"bolt": 5}, ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := second.Receive(map[string]int{"bolt": 5}, ""); err == nil {
+		t.Error("the delivery was received twice")
+	}
+	if q := stock(t).Quantity; q != 5 {
+		t.Errorf("the stock is %d, want 5", q)
+	}
+}
+
+// failingStore fails to append to the files of one record.
+type failingStore struct {
+	storage.Store
+	key string
+}
+
+func (s *failingStore) Append(collection, key, name string, data []byte) error {
+	if key == s.key {
+		return errors.New("disk full")
+	}
+	return s.Store.Append(collection, key, name, data)
+}
+
+func TestReceiveRetry(t *testing.T) {
+	setup(t, 0)
+	nut := &inventory.Item{ID: "nut", Name: "Nut"}
+	if err := nut.Update(); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := Add("acme", time.Time{}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"bolt", "nut"} {
+		if err := p.AddLine(id, 5, inventory.Money{Amount: 25, Currency: "AUD"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := p.Move(Ordered); err != nil {
+		t.Fatal(err)
+	}
+
+	// The ledger of the nuts cannot be written, after the bolts are received.
+	delivery := map[string]int{"bolt": 5, "nut": 5}
+	inventory.Store = &failingStore{Store: Store, key: "nut"}
+	if err := p.Receive(delivery, ""); err == nil {
+		t.Fatal("the delivery was received without the nuts")
+	}
+	inventory.Store = Store
+	if err := p.Receive(delivery, ""); err == nil {
+		t.Error("the bolts were received twice")
+	}
+	if err := p.Receive(map[string]int{"nut": 5}, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if q := stock(t).Quantity; q != 5 {
+		t.Errorf("the stock of bolts is %d, want 5", q)
+	}
+	if p.State != Received {
+		t.Errorf("the state is %s, want %s", p.State, Received)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/medoix/warehouse/equipment"
+	"github.com/medoix/warehouse/notify"
+)
+
+// remindEvery is how often the loans are checked for overdue reminders.
+const remindEvery = time.Hour
+
+// remindOverdue checks the loans every `remindEvery` and sends a reminder for
+// each overdue loan, once per `equipment.RemindInterval`. Reminders are always
+// logged, and sent to the notifier if any. It never returns, so it is run in
+// the background.
+func remindOverdue(n notify.Notifier) {
+	for {
+		err := equipment.Remind(func(l *equipment.Loan) error {
+			subject, message := overdueMessage(l)
+			log.Println("[OVERDUE]", subject)
+			if n == nil {
+				return nil
+			}
+			return n.Notify(subject, message)
+		})
+		if err != nil {
+			log.Println("[ERR]", err)
+		}
+		time.Sleep(remindEvery)
+	}
+}
+
+func overdueMessage(l *equipment.Loan) (string, string) {
+	subject := fmt.Sprintf("Overdue %s (%s) borrowed by %s", l.Name, l.ID, l.Who)
+	message := fmt.Sprintf("%s was borrowed by %s on %s and was due back on %s, %d days ago.\n",
+		l.Name, l.Who, l.Since.Format("02/01/06"), l.Due.Format("02/01/06"), l.DaysOverdue())
+
+	return subject, message
+}
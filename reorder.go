@@ -0,0 +1,79 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/medoix/warehouse/inventory"
+	"github.com/medoix/warehouse/notify"
+	"github.com/medoix/warehouse/suppliers"
+)
+
+// notifiers is the list of notifiers passed with the repeatable `-notify`
+// flag.
+type notifiers notify.Multi
+
+// String implements the flag.Value interface.
+func (n *notifiers) String() string {
+	return fmt.Sprintf("%d notifiers", len(*n))
+}
+
+// Set implements the flag.Value interface.
+func (n *notifiers) Set(spec string) error {
+	notifier, err := notify.Parse(spec)
+	if err != nil {
+		return err
+	}
+	*n = append(*n, notifier)
+	return nil
+}
+
+// reorderAlert returns the function called when an item falls to its reorder
+// point. The alert is always logged, and sent to the notifiers in the
+// background so it does not slow down the change that triggered it.
+func reorderAlert(n notify.Notifier) func(i *inventory.Item) {
+	return func(i *inventory.Item) {
+		subject, message := reorderMessage(i)
+		log.Println("[REORDER]", subject)
+		if n == nil {
+			return
+		}
+
+		go func() {
+			if err := n.Notify(subject, message); err != nil {
+				log.Println("[ERR]", err)
+			}
+		}()
+	}
+}
+
+func reorderMessage(i *inventory.Item) (string, string) {
+	subject := fmt.Sprintf("Reorder %s (%s)", i.Name, i.ID)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s is down to %d, at or below its reorder point of %d.\n", i.Name, i.Quantity, i.ReorderPoint)
+	if i.ReorderQuantity > 0 {
+		fmt.Fprintf(&b, "Suggested reorder quantity: %d.\n", i.ReorderQuantity)
+	}
+	for _, l := range i.Suppliers {
+		s, err := suppliers.Get(l.Supplier)
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(&b, "Supplier: %s", s.Name)
+		if l.SKU != "" {
+			fmt.Fprintf(&b, ", SKU %s", l.SKU)
+		}
+		if l.LastCost.Amount != 0 {
+			fmt.Fprintf(&b, ", last cost %s", l.LastCost)
+		}
+		if s.LeadTime > 0 {
+			fmt.Fprintf(&b, ", lead time %d days", s.LeadTime)
+		}
+		b.WriteString(".\n")
+		break
+	}
+
+	return subject, b.String()
+}
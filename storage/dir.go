@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Dir stores the warehouse as a tree of directories: one directory per
+// collection, holding one directory per record with its files.
+type Dir struct {
+	Path string
+}
+
+// NewDir returns a store rooted at the given directory.
+func NewDir(path string) *Dir {
+	return &Dir{Path: path}
+}
+
+// Collections implements the Store interface.
+func (d *Dir) Collections() ([]string, error) {
+	return d.dirs(d.Path)
+}
+
+// Keys implements the Store interface.
+func (d *Dir) Keys(collection string) ([]string, error) {
+	if err := check(collection, "_", ""); err != nil {
+		return nil, err
+	}
+	return d.dirs(filepath.Join(d.Path, collection))
+}
+
+// Exists implements the Store interface.
+func (d *Dir) Exists(collection, key string) (bool, error) {
+	if err := check(collection, key, ""); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(d.file(collection, key, ""))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("storage: could not stat record: %w", err)
+	}
+	return true, nil
+}
+
+// Files implements the Store interface.
+func (d *Dir) Files(collection, key string) ([]string, error) {
+	if err := check(collection, key, ""); err != nil {
+		return nil, err
+	}
+
+	root := d.file(collection, key, "")
+	names := []string{}
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		names = append(names, filepath.ToSlash(name))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("storage: could not list files: %w", err)
+	}
+
+	return names, nil
+}
+
+// ReadAll implements the Store interface. The files are read concurrently.
+func (d *Dir) ReadAll(collection, name string) (map[string][]byte, error) {
+	keys, err := d.Keys(collection)
+	if err != nil {
+		return nil, err
+	}
+
+	var readers sync.WaitGroup
+	var mu sync.Mutex
+	files := map[string][]byte{}
+
+	readers.Add(len(keys))
+	for _, key := range keys {
+		key := key
+		go func() {
+			defer readers.Done()
+			data, e := d.Read(collection, key, name)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case e == ErrNotExist:
+			case e != nil:
+				err = fmt.Errorf("%v\n%w", err, e)
+			default:
+				files[key] = data
+			}
+		}()
+	}
+	readers.Wait()
+
+	return files, err
+}
+
+// Read implements the Store interface.
+func (d *Dir) Read(collection, key, name string) ([]byte, error) {
+	if err := check(collection, key, name); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(d.file(collection, key, name))
+	if os.IsNotExist(err) {
+		return nil, ErrNotExist
+	} else if err != nil {
+		return nil, fmt.Errorf("storage: could not read file: %w", err)
+	}
+	return data, nil
+}
+
+// Write implements the Store interface.
+func (d *Dir) Write(collection, key, name string, data []byte) error {
+	if err := check(collection, key, name); err != nil {
+		return err
+	}
+	file := d.file(collection, key, name)
+	if err := os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return fmt.Errorf("storage: could not create directory: %w", err)
+	}
+	if err := ioutil.WriteFile(file, data, 0644); err != nil {
+		return fmt.Errorf("storage: could not write file: %w", err)
+	}
+	return nil
+}
+
+// Append implements the Store interface.
+func (d *Dir) Append(collection, key, name string, data []byte) error {
+	if err := check(collection, key, name); err != nil {
+		return err
+	}
+	path := d.file(collection, key, name)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return fmt.Errorf("storage: could not create directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("storage: could not open file: %w", err)
+	}
+	defer file.Close()
+	if _, err := file.Write(data); err != nil {
+		return fmt.Errorf("storage: could not write file: %w", err)
+	}
+	return nil
+}
+
+// Delete implements the Store interface.
+func (d *Dir) Delete(collection, key string) error {
+	if err := check(collection, key, ""); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(d.file(collection, key, "")); err != nil {
+		return fmt.Errorf("storage: could not delete record: %w", err)
+	}
+	return nil
+}
+
+// Close implements the Store interface.
+func (d *Dir) Close() error {
+	return nil
+}
+
+func (d *Dir) file(collection, key, name string) string {
+	return filepath.Join(d.Path, collection, key, filepath.FromSlash(name))
+}
+
+// dirs returns the names of the directories inside a directory, creating it
+// if it does not exist yet.
+func (d *Dir) dirs(path string) ([]string, error) {
+	infos, err := ioutil.ReadDir(path)
+	if os.IsNotExist(err) {
+		if err := os.MkdirAll(path, os.ModePerm); err != nil {
+			return nil, fmt.Errorf("storage: could not create directory: %w", err)
+		}
+		return []string{}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("storage: could not read directory: %w", err)
+	}
+
+	names := []string{}
+	for _, info := range infos {
+		if info.IsDir() {
+			names = append(names, info.Name())
+		}
+	}
+	return names, nil
+}
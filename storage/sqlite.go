@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	// Pure Go SQLite driver, so the binary can still be built without cgo.
+	_ "modernc.org/sqlite"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS files (
+	collection TEXT NOT NULL,
+	key        TEXT NOT NULL,
+	name       TEXT NOT NULL,
+	data       BLOB NOT NULL,
+	PRIMARY KEY (collection, key, name)
+);`
+
+// SQLite stores the warehouse in a single SQLite database file. Records keep
+// the same files as in the directory layout, one row per file.
+type SQLite struct {
+	db *sql.DB
+}
+
+// OpenSQLite opens the SQLite database at the given path, creating it if it
+// does not exist.
+func OpenSQLite(path string) (*SQLite, error) {
+	db, err := sql.Open("sqlite", path+"?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)")
+	if err != nil {
+		return nil, fmt.Errorf("storage: could not open database: %w", err)
+	}
+	// SQLite only allows one writer at a time.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("storage: could not create database: %w", err)
+	}
+
+	return &SQLite{db: db}, nil
+}
+
+// Collections implements the Store interface.
+func (s *SQLite) Collections() ([]string, error) {
+	return s.strings(`SELECT DISTINCT collection FROM files ORDER BY collection`)
+}
+
+// Keys implements the Store interface.
+func (s *SQLite) Keys(collection string) ([]string, error) {
+	if err := check(collection, "_", ""); err != nil {
+		return nil, err
+	}
+	return s.strings(`SELECT DISTINCT key FROM files WHERE collection = ? ORDER BY key`, collection)
+}
+
+// Exists implements the Store interface.
+func (s *SQLite) Exists(collection, key string) (bool, error) {
+	if err := check(collection, key, ""); err != nil {
+		return false, err
+	}
+
+	var one int
+	err := s.db.QueryRow(`SELECT 1 FROM files WHERE collection = ? AND key = ? LIMIT 1`, collection, key).Scan(&one)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("storage: could not query record: %w", err)
+	}
+	return true, nil
+}
+
+// Files implements the Store interface.
+func (s *SQLite) Files(collection, key string) ([]string, error) {
+	if err := check(collection, key, ""); err != nil {
+		return nil, err
+	}
+	return s.strings(`SELECT name FROM files WHERE collection = ? AND key = ? ORDER BY name`, collection, key)
+}
+
+// ReadAll implements the Store interface.
+func (s *SQLite) ReadAll(collection, name string) (map[string][]byte, error) {
+	if err := check(collection, "_", name); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query(`SELECT key, data FROM files WHERE collection = ? AND name = ?`, collection, name)
+	if err != nil {
+		return nil, fmt.Errorf("storage: could not query files: %w", err)
+	}
+	defer rows.Close()
+
+	files := map[string][]byte{}
+	for rows.Next() {
+		var key string
+		var data []byte
+		if err := rows.Scan(&key, &data); err != nil {
+			return nil, fmt.Errorf("storage: could not read file: %w", err)
+		}
+		files[key] = data
+	}
+
+	return files, rows.Err()
+}
+
+// Read implements the Store interface.
+func (s *SQLite) Read(collection, key, name string) ([]byte, error) {
+	if err := check(collection, key, name); err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	err := s.db.QueryRow(`SELECT data FROM files WHERE collection = ? AND key = ? AND name = ?`, collection, key, name).Scan(&data)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotExist
+	} else if err != nil {
+		return nil, fmt.Errorf("storage: could not read file: %w", err)
+	}
+	return data, nil
+}
+
+// Write implements the Store interface.
+func (s *SQLite) Write(collection, key, name string, data []byte) error {
+	if err := check(collection, key, name); err != nil {
+		return err
+	}
+
+	_, err := s.db.Exec(`INSERT INTO files (collection, key, name, data) VALUES (?, ?, ?, ?)
+		ON CONFLICT (collection, key, name) DO UPDATE SET data = excluded.data`,
+		collection, key, name, data)
+	if err != nil {
+		return fmt.Errorf("storage: could not write file: %w", err)
+	}
+	return nil
+}
+
+// Append implements the Store interface.
+func (s *SQLite) Append(collection, key, name string, data []byte) error {
+	if err := check(collection, key, name); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("storage: could not append to file: %w", err)
+	}
+	defer tx.Rollback()
+
+	var old []byte
+	err = tx.QueryRow(`SELECT data FROM files WHERE collection = ? AND key = ? AND name = ?`, collection, key, name).Scan(&old)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("storage: could not append to file: %w", err)
+	}
+	_, err = tx.Exec(`INSERT INTO files (collection, key, name, data) VALUES (?, ?, ?, ?)
+		ON CONFLICT (collection, key, name) DO UPDATE SET data = excluded.data`,
+		collection, key, name, append(old, data...))
+	if err != nil {
+		return fmt.Errorf("storage: could not append to file: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// Delete implements the Store interface.
+func (s *SQLite) Delete(collection, key string) error {
+	if err := check(collection, key, ""); err != nil {
+		return err
+	}
+	if _, err := s.db.Exec(`DELETE FROM files WHERE collection = ? AND key = ?`, collection, key); err != nil {
+		return fmt.Errorf("storage: could not delete record: %w", err)
+	}
+	return nil
+}
+
+// Close implements the Store interface.
+func (s *SQLite) Close() error {
+	return s.db.Close()
+}
+
+func (s *SQLite) strings(query string, args ...interface{}) ([]string, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("storage: could not query database: %w", err)
+	}
+	defer rows.Close()
+
+	values := []string{}
+	for rows.Next() {
+		var v string
+		if err := rows.Scan(&v); err != nil {
+			return nil, fmt.Errorf("storage: could not query database: %w", err)
+		}
+		values = append(values, v)
+	}
+	return values, rows.Err()
+}
@@ -0,0 +1,122 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// ErrNotExist is returned when reading a file that does not exist.
+var ErrNotExist = errors.New("storage: file does not exist")
+
+// Store is a storage backend of the warehouse. Records are grouped in
+// collections (e.g. "inventory") and identified by a key. Each record holds a
+// set of named files such as its yaml information, pictures and logs.
+type Store interface {
+	// Collections returns the names of the collections in the store.
+	Collections() ([]string, error)
+	// Keys returns the keys of the records in a collection.
+	Keys(collection string) ([]string, error)
+	// Exists reports whether a record exists in a collection.
+	Exists(collection, key string) (bool, error)
+	// Files returns the names of the files of a record.
+	Files(collection, key string) ([]string, error)
+	// ReadAll returns the named file of every record of a collection by key.
+	// Records without that file are left out.
+	ReadAll(collection, name string) (map[string][]byte, error)
+	// Read returns the content of a file of a record.
+	Read(collection, key, name string) ([]byte, error)
+	// Write sets the content of a file of a record, creating the record if
+	// needed.
+	Write(collection, key, name string, data []byte) error
+	// Append adds data at the end of a file of a record.
+	Append(collection, key, name string, data []byte) error
+	// Delete deletes a record and all its files.
+	Delete(collection, key string) error
+	// Close releases the resources held by the store.
+	Close() error
+}
+
+// DefaultPath returns the default warehouse directory.
+func DefaultPath() string {
+	home, err := homedir.Dir()
+	if err != nil {
+		panic(err)
+	}
+	return filepath.Join(home, ".warehouse")
+}
+
+// Copy copies every record of the source store into the destination store.
+func Copy(dst, src Store) error {
+	collections, err := src.Collections()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range collections {
+		keys, err := src.Keys(c)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			names, err := src.Files(c, k)
+			if err != nil {
+				return err
+			}
+			for _, n := range names {
+				data, err := src.Read(c, k, n)
+				if err != nil {
+					return err
+				}
+				if err := dst.Write(c, k, n, data); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Handler serves the files of the records of a collection at "<key>/<name>",
+// e.g. the pictures of the items.
+func Handler(s Store, collection string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+		slash := strings.Index(p, "/")
+		if slash < 0 {
+			http.NotFound(w, r)
+			return
+		}
+
+		data, err := s.Read(collection, p[:slash], p[slash+1:])
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, p, time.Time{}, strings.NewReader(string(data)))
+	})
+}
+
+// check validates the parts of the path of a file so they cannot escape their
+// collection or record.
+func check(collection, key, name string) error {
+	for _, part := range []string{collection, key} {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `/\`) {
+			return fmt.Errorf("storage: invalid record %s/%s", collection, key)
+		}
+	}
+	if name == "" {
+		return nil
+	}
+	if strings.Contains(name, `\`) || path.IsAbs(name) || path.Clean(name) != name || strings.HasPrefix(name, "..") {
+		return fmt.Errorf("storage: invalid file name %q", name)
+	}
+	return nil
+}
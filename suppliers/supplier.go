@@ -0,0 +1,62 @@
+package suppliers
+
+import (
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	collection   = "suppliers"
+	supplierYAML = "info.yaml"
+)
+
+// Supplier is a supplier the inventory is restocked from.
+type Supplier struct {
+	ID      string `yaml:"id" json:"id"`
+	Name    string `yaml:"name" json:"name"`
+	Contact string `yaml:"contact" json:"contact"`
+	Email   string `yaml:"email" json:"email"`
+	Phone   string `yaml:"phone" json:"phone"`
+	// LeadTime is the number of days the supplier takes to deliver an
+	// order.
+	LeadTime int `yaml:"lead_time" json:"lead_time"`
+	// Currency is the currency the supplier bills in, used for the costs
+	// entered without one.
+	Currency string    `yaml:"currency" json:"currency"`
+	Notes    string    `yaml:"notes" json:"notes"`
+	Created  time.Time `yaml:"created" json:"created"`
+	Updated  time.Time `yaml:"update" json:"updated"`
+}
+
+// Update updates the information of the supplier in the store.
+func (s *Supplier) Update() error {
+	s.Updated = time.Now()
+
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("suppliers: could not marshal yaml file: %w", err)
+	}
+
+	if err := Store.Write(collection, s.ID, supplierYAML, data); err != nil {
+		return fmt.Errorf("suppliers: could not write supplier: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes the supplier from the store.
+func (s *Supplier) Delete() error {
+	err := Store.Delete(collection, s.ID)
+	if err != nil {
+		return fmt.Errorf("suppliers: could not delete supplier: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (s *Supplier) String() string {
+	return fmt.Sprintf("{%s (%s), Updated: %v}", s.ID, s.Name, s.Updated)
+}
@@ -0,0 +1,173 @@
+package suppliers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Store is the storage backend of the suppliers (default: the
+	// ~/.warehouse directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
+	// ErrNotFound is returned when a supplier does not exist.
+	ErrNotFound = errors.New("suppliers: supplier not found")
+	// ErrNoName is returned when adding or updating a supplier without a
+	// name.
+	ErrNoName = errors.New("suppliers: a supplier needs a name")
+)
+
+// Suppliers returns the list of suppliers sorted by name.
+func Suppliers() ([]*Supplier, error) {
+	files, err := Store.ReadAll(collection, supplierYAML)
+	if err != nil {
+		return nil, fmt.Errorf("suppliers: could not read suppliers: %w", err)
+	}
+
+	suppliers := []*Supplier{}
+	for _, data := range files {
+		var s Supplier
+		if e := yaml.Unmarshal(data, &s); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("suppliers: could not parse supplier: %w", e))
+			continue
+		}
+		suppliers = append(suppliers, &s)
+	}
+	sort.Slice(suppliers, func(a, b int) bool {
+		return strings.ToLower(suppliers[a].Name) < strings.ToLower(suppliers[b].Name)
+	})
+
+	return suppliers, err
+}
+
+// Names returns the names of the suppliers by ID.
+func Names() (map[string]string, error) {
+	suppliers, err := Suppliers()
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	for _, s := range suppliers {
+		names[s.ID] = s.Name
+	}
+	return names, nil
+}
+
+// Add adds a new supplier. It will auto-generate a unique ID for the supplier
+// based on the name.
+func Add(s *Supplier) (*Supplier, error) {
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	s.ID = uniqueKey(s.Name)
+	s.Created = time.Now()
+
+	if err := s.Update(); err != nil {
+		return nil, fmt.Errorf("suppliers: could not add supplier: %w", err)
+	}
+
+	return s, nil
+}
+
+// Get returns the supplier with the given ID.
+func Get(id string) (*Supplier, error) {
+	if ok, err := Store.Exists(collection, id); err != nil || !ok {
+		return nil, ErrNotFound
+	}
+
+	s := &Supplier{ID: id}
+	data, err := Store.Read(collection, id, supplierYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("suppliers: could not read supplier: %w", err)
+	}
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("suppliers: could not parse supplier: %w", err)
+	}
+
+	return s, nil
+}
+
+// Update replaces the information of the supplier with the same ID. The
+// creation date of the supplier is kept.
+func Update(s *Supplier) (*Supplier, error) {
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	old, err := Get(s.ID)
+	if err != nil {
+		return nil, err
+	}
+	s.Created = old.Created
+
+	if err := s.Update(); err != nil {
+		return nil, fmt.Errorf("suppliers: could not update supplier: %w", err)
+	}
+
+	return s, nil
+}
+
+// Delete deletes a supplier.
+func Delete(id string) error {
+	s, err := Get(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Delete(); err != nil {
+		return fmt.Errorf("suppliers: could not delete supplier: %w", err)
+	}
+	return nil
+}
+
+// check validates the fields of the supplier and normalizes its currency.
+func (s *Supplier) check() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return ErrNoName
+	}
+	if s.LeadTime < 0 {
+		return fmt.Errorf("suppliers: the lead time cannot be negative")
+	}
+	s.Currency = strings.ToUpper(strings.TrimSpace(s.Currency))
+	if s.Currency != "" && !currency.MatchString(s.Currency) {
+		return fmt.Errorf("suppliers: %q is not a currency code", s.Currency)
+	}
+	return nil
+}
+
+var currency = regexp.MustCompile(`^[A-Z]{3}$`)
+
+func uniqueKey(key string) string {
+	mark := 'a'
+	key = fmt.Sprintf("%.10s", clean(key))
+	if key == "" {
+		key = "supplier"
+	}
+	valid := key
+
+	for exists(valid) {
+		valid = fmt.Sprintf("%s_%s", key, string(mark))
+		mark++
+	}
+
+	return valid
+}
+
+func exists(key string) bool {
+	ok, err := Store.Exists(collection, key)
+	return ok || err != nil
+}
+
+var nonAlnum = regexp.MustCompile("[^[:alnum:]]+")
+
+func clean(s string) string {
+	return strings.ToLower(nonAlnum.ReplaceAllString(s, ""))
+}
@@ -0,0 +1,43 @@
+package users
+
+import "fmt"
+
+// Role sets what a user is allowed to do. Each role can do everything the
+// roles below it can.
+type Role string
+
+const (
+	// Borrower can only check equipment out and in.
+	Borrower Role = "borrower"
+	// Staff can also add and edit inventory and equipment.
+	Staff Role = "staff"
+	// Admin can also delete items and manage users.
+	Admin Role = "admin"
+)
+
+// Roles lists the roles from the least to the most privileged.
+var Roles = []Role{Borrower, Staff, Admin}
+
+// ParseRole returns the role with the given name.
+func ParseRole(s string) (Role, error) {
+	for _, r := range Roles {
+		if string(r) == s {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("users: unknown role %q", s)
+}
+
+// Allows reports whether the role has at least the privileges of another.
+func (r Role) Allows(min Role) bool {
+	return r.level() >= min.level()
+}
+
+func (r Role) level() int {
+	for i, role := range Roles {
+		if role == r {
+			return i
+		}
+	}
+	return -1
+}
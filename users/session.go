@@ -0,0 +1,75 @@
+package users
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SessionLifetime is how long a session lasts without being used (default: 7
+// days).
+var SessionLifetime = 7 * 24 * time.Hour
+
+type session struct {
+	username string
+	expires  time.Time
+}
+
+// Sessions are kept in memory, so restarting the server logs everyone out.
+var (
+	sessionsMu sync.Mutex
+	sessions   = map[string]*session{}
+)
+
+// NewSession starts a session for the user and returns its token.
+func NewSession(u *User) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("users: could not create session: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+	sessions[token] = &session{
+		username: u.Username,
+		expires:  time.Now().Add(SessionLifetime),
+	}
+
+	return token, nil
+}
+
+// Session returns the user of a session and extends it. Sessions of users
+// that were deleted since are ended.
+func Session(token string) (*User, error) {
+	sessionsMu.Lock()
+	s, ok := sessions[token]
+	if ok && time.Now().After(s.expires) {
+		delete(sessions, token)
+		ok = false
+	}
+	if ok {
+		s.expires = time.Now().Add(SessionLifetime)
+	}
+	sessionsMu.Unlock()
+
+	if !ok {
+		return nil, ErrInvalidLogin
+	}
+
+	u, err := Get(s.username)
+	if err == ErrNotFound {
+		EndSession(token)
+		return nil, ErrInvalidLogin
+	}
+	return u, err
+}
+
+// EndSession ends a session.
+func EndSession(token string) {
+	sessionsMu.Lock()
+	defer sessionsMu.Unlock()
+	delete(sessions, token)
+}
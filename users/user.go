@@ -0,0 +1,80 @@
+package users
+
+import (
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	collection = "users"
+	userYAML   = "info.yaml"
+)
+
+// User is a local account of the warehouse.
+type User struct {
+	Username string    `yaml:"username" json:"username"`
+	Hash     string    `yaml:"hash" json:"-"`
+	Role     Role      `yaml:"role" json:"role"`
+	Created  time.Time `yaml:"created" json:"created"`
+	Updated  time.Time `yaml:"update" json:"updated"`
+}
+
+// Update updates the information of the user in the store.
+func (u *User) Update() error {
+	u.Updated = time.Now()
+
+	data, err := yaml.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("users: could not marshal yaml file: %w", err)
+	}
+
+	if err := Store.Write(collection, u.Username, userYAML, data); err != nil {
+		return fmt.Errorf("users: could not write user: %w", err)
+	}
+
+	return nil
+}
+
+// SetPassword hashes the password of the user with bcrypt. The password is
+// never stored in clear.
+func (u *User) SetPassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return fmt.Errorf("users: the password must have at least %d characters", MinPasswordLength)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("users: could not hash password: %w", err)
+	}
+	u.Hash = string(hash)
+
+	return nil
+}
+
+// CheckPassword reports whether the password matches the one of the user.
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.Hash), []byte(password)) == nil
+}
+
+// Delete deletes the user from the store.
+func (u *User) Delete() error {
+	err := Store.Delete(collection, u.Username)
+	if err != nil {
+		return fmt.Errorf("users: could not delete user: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (u *User) String() string {
+	return fmt.Sprintf("{%s, Role: %s}", u.Username, u.Role)
+}
+
+// Can reports whether the user has at least the privileges of a role.
+func (u *User) Can(role Role) bool {
+	return u != nil && u.Role.Allows(role)
+}
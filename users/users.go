@@ -0,0 +1,151 @@
+package users
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/medoix/warehouse/storage"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// Store is the storage backend of the users (default: the ~/.warehouse
+	// directory).
+	Store storage.Store = storage.NewDir(storage.DefaultPath())
+	// MinPasswordLength is the minimum length of the passwords (default: 8).
+	MinPasswordLength = 8
+	// ErrNotFound is returned when a user does not exist.
+	ErrNotFound = errors.New("users: user not found")
+	// ErrExists is returned when adding a user that already exists.
+	ErrExists = errors.New("users: user already exists")
+	// ErrInvalidLogin is returned when a username or password is wrong.
+	ErrInvalidLogin = errors.New("users: invalid username or password")
+)
+
+var validName = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,31}$`)
+
+// Users returns the list of users sorted by username.
+func Users() ([]*User, error) {
+	files, err := Store.ReadAll(collection, userYAML)
+	if err != nil {
+		return nil, fmt.Errorf("users: could not read users: %w", err)
+	}
+
+	users := []*User{}
+	for _, data := range files {
+		var u User
+		if e := yaml.Unmarshal(data, &u); e != nil {
+			err = fmt.Errorf("%v\n%w", err, fmt.Errorf("users: could not parse user: %w", e))
+			continue
+		}
+		users = append(users, &u)
+	}
+	sort.Slice(users, func(a, b int) bool {
+		return users[a].Username < users[b].Username
+	})
+
+	return users, err
+}
+
+// Add adds a new user. Usernames are lower case and may contain letters,
+// digits, dots, dashes and underscores.
+func Add(username, password string, role Role) (*User, error) {
+	username = strings.ToLower(strings.TrimSpace(username))
+	if !validName.MatchString(username) {
+		return nil, fmt.Errorf("users: invalid username %q", username)
+	}
+	if role.level() < 0 {
+		return nil, fmt.Errorf("users: unknown role %q", role)
+	}
+	if ok, err := Store.Exists(collection, username); err != nil {
+		return nil, fmt.Errorf("users: could not add user: %w", err)
+	} else if ok {
+		return nil, ErrExists
+	}
+
+	u := &User{
+		Username: username,
+		Role:     role,
+		Created:  time.Now(),
+	}
+	if err := u.SetPassword(password); err != nil {
+		return nil, err
+	}
+	if err := u.Update(); err != nil {
+		return nil, fmt.Errorf("users: could not add user: %w", err)
+	}
+
+	return u, nil
+}
+
+// Get returns the user with the given username.
+func Get(username string) (*User, error) {
+	username = strings.ToLower(username)
+	if !validName.MatchString(username) {
+		return nil, ErrNotFound
+	}
+
+	data, err := Store.Read(collection, username, userYAML)
+	if err == storage.ErrNotExist {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("users: could not read user: %w", err)
+	}
+
+	u := &User{}
+	if err := yaml.Unmarshal(data, u); err != nil {
+		return nil, fmt.Errorf("users: could not parse user: %w", err)
+	}
+
+	return u, nil
+}
+
+// Authenticate returns the user matching the username and password.
+func Authenticate(username, password string) (*User, error) {
+	u, err := Get(username)
+	if err == ErrNotFound {
+		return nil, ErrInvalidLogin
+	} else if err != nil {
+		return nil, err
+	}
+	if !u.CheckPassword(password) {
+		return nil, ErrInvalidLogin
+	}
+
+	return u, nil
+}
+
+// Update changes the role of a user, and its password unless it is empty.
+func Update(username, password string, role Role) (*User, error) {
+	u, err := Get(username)
+	if err != nil {
+		return nil, err
+	}
+	if role.level() < 0 {
+		return nil, fmt.Errorf("users: unknown role %q", role)
+	}
+	u.Role = role
+	if password != "" {
+		if err := u.SetPassword(password); err != nil {
+			return nil, err
+		}
+	}
+	if err := u.Update(); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// Delete deletes a user.
+func Delete(username string) error {
+	u, err := Get(username)
+	if err != nil {
+		return err
+	}
+	return u.Delete()
+}